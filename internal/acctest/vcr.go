@@ -4,15 +4,11 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -21,14 +17,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
@@ -46,11 +43,11 @@ type randomnessSource struct {
 type metaMap map[string]*conns.AWSClient
 
 func (m metaMap) Lock() {
-	conns.GlobalMutexKV.Lock(m.key())
+	conns.GlobalKeyedLock.Lock(context.Background(), m.key(), 0) //nolint:errcheck // Can't fail without a deadline.
 }
 
 func (m metaMap) Unlock() {
-	conns.GlobalMutexKV.Unlock(m.key())
+	conns.GlobalKeyedLock.Unlock(context.Background(), m.key())
 }
 
 func (m metaMap) key() string {
@@ -60,11 +57,11 @@ func (m metaMap) key() string {
 type randomnessSourceMap map[string]*randomnessSource
 
 func (m randomnessSourceMap) Lock() {
-	conns.GlobalMutexKV.Lock(m.key())
+	conns.GlobalKeyedLock.Lock(context.Background(), m.key(), 0) //nolint:errcheck // Can't fail without a deadline.
 }
 
 func (m randomnessSourceMap) Unlock() {
-	conns.GlobalMutexKV.Unlock(m.key())
+	conns.GlobalKeyedLock.Unlock(context.Background(), m.key())
 }
 
 func (m randomnessSourceMap) key() string {
@@ -116,7 +113,7 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(t, primary, primary.ConfigureContextFunc)
 
 			return providerServerFactory(), nil
 		}
@@ -128,7 +125,9 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+func vcrProviderConfigureContextFunc(t *testing.T, provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	testName := t.Name()
+
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		providerMetas.Lock()
 		meta, ok := providerMetas[testName]
@@ -177,76 +176,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}, recorder.AfterCaptureHook)
 
 		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
+		r.SetMatcher(vcr.Matcher(ctx))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
@@ -266,6 +196,15 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
+		// Route provider-generated names and tokens through the test's seeded randomness source.
+		s, err := vcrRandomnessSource(t)
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		meta.RandomnessSource = create.NewRandomnessSource(s.seed)
+
 		// Don't retry requests if a recorded interaction isn't found.
 		// TODO Need to loop through all API clients to do this.
 		// TODO Use []*client.Client?
@@ -0,0 +1,101 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumeRoleHopError is the error returned when a role in an assume role chain can't be assumed.
+type assumeRoleHopError struct {
+	hop, hops int
+	roleARN   string
+	err       error
+}
+
+func (e *assumeRoleHopError) Error() string {
+	return fmt.Sprintf("assuming IAM Role (%s) in assume_role %d of %d: %s", e.roleARN, e.hop, e.hops, e.err)
+}
+
+func (e *assumeRoleHopError) Unwrap() error {
+	return e.err
+}
+
+// assumeRoleChain assumes the specified IAM Roles in sequence, starting with the credentials in cfg.
+// Each role is assumed using the credentials of the previous role.
+// The first hop is numbered offset+1, as the preceding hops have already been assumed.
+func assumeRoleChain(ctx context.Context, cfg *aws_sdkv2.Config, chain []*awsbase.AssumeRole, offset int, stsEndpoint, stsRegion string) error {
+	hops := offset + len(chain)
+
+	for i, ar := range chain {
+		hop := offset + i + 1
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             hop,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts_sdkv2.NewFromConfig(*cfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		credentials := aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		}))
+
+		// Assume the role now so that any failure is attributed to this hop.
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return &assumeRoleHopError{hop: hop, hops: hops, roleARN: ar.RoleARN, err: err}
+		}
+
+		cfg.Credentials = credentials
+	}
+
+	return nil
+}
+
+func expandAssumeRoleOptions(o *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.RoleSessionName = ar.SessionName
+	o.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = ar.TransitiveTagKeys
+}
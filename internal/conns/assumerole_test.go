@@ -0,0 +1,127 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// newSTSStubServer returns a local STS endpoint that allows roles whose ARN contains "allowed" to be assumed.
+// Each role's credentials have the role's name as access key ID.
+// The access key ID used to sign each AssumeRole request is recorded.
+func newSTSStubServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var lock sync.Mutex
+	var callers []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Credential=AKID/20230101/us-east-1/sts/aws4_request, ...
+		auth := r.Header.Get("Authorization")
+		caller := auth[strings.Index(auth, "Credential=")+len("Credential=") : strings.Index(auth, "/")]
+		lock.Lock()
+		callers = append(callers, caller)
+		lock.Unlock()
+
+		roleARN := r.Form.Get("RoleArn")
+		w.Header().Set("Content-Type", "text/xml")
+
+		if !strings.Contains(roleARN, "allowed") {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>Not authorized to perform sts:AssumeRole on %s</Message></Error></ErrorResponse>`, roleARN) //nolint:errcheck // Test stub.
+			return
+		}
+
+		name := roleARN[strings.LastIndex(roleARN, "/")+1:]
+		fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>%[1]s</AccessKeyId><SecretAccessKey>SECRET</SecretAccessKey><SessionToken>TOKEN</SessionToken><Expiration>2100-01-01T00:00:00Z</Expiration></Credentials><AssumedRoleUser><Arn>%[2]s</Arn><AssumedRoleId>AROA:%[1]s</AssumedRoleId></AssumedRoleUser></AssumeRoleResult></AssumeRoleResponse>`, name, roleARN) //nolint:errcheck // Test stub.
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return callers
+	}
+}
+
+func newAssumeRoleChainTestConfig() *aws_sdkv2.Config {
+	return &aws_sdkv2.Config{
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{AccessKeyID: "BASE", SecretAccessKey: "SECRET"}, nil
+		}),
+		Region: "us-east-1", //lintignore:AWSAT003
+	}
+}
+
+func TestAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, callers := newSTSStubServer(t)
+	cfg := newAssumeRoleChainTestConfig()
+
+	chain := []*awsbase.AssumeRole{
+		{RoleARN: "arn:aws:iam::111111111111:role/allowed-identity", ExternalID: "external1"}, //lintignore:AWSAT005
+		{RoleARN: "arn:aws:iam::222222222222:role/allowed-workload", ExternalID: "external2"}, //lintignore:AWSAT005
+	}
+
+	if err := assumeRoleChain(ctx, cfg, chain, 0, server.URL, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	credentials, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := credentials.AccessKeyID, "allowed-workload"; got != want {
+		t.Errorf("AccessKeyID = %q, want %q", got, want)
+	}
+
+	// Each role is assumed using the previous role's credentials.
+	if got, want := strings.Join(callers(), ","), "BASE,allowed-identity"; got != want {
+		t.Errorf("callers = %q, want %q", got, want)
+	}
+}
+
+func TestAssumeRoleChainFailedHop(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, _ := newSTSStubServer(t)
+	cfg := newAssumeRoleChainTestConfig()
+
+	chain := []*awsbase.AssumeRole{
+		{RoleARN: "arn:aws:iam::111111111111:role/allowed-identity"}, //lintignore:AWSAT005
+		{RoleARN: "arn:aws:iam::222222222222:role/denied-workload"},  //lintignore:AWSAT005
+	}
+
+	// The first hop of three has already been assumed.
+	err := assumeRoleChain(ctx, cfg, chain, 1, server.URL, "")
+
+	var hopErr *assumeRoleHopError
+	if !errors.As(err, &hopErr) {
+		t.Fatalf("expected assumeRoleHopError, got %v", err)
+	}
+
+	if got, want := hopErr.hop, 3; got != want {
+		t.Errorf("hop = %d, want %d", got, want)
+	}
+	if got, want := err.Error(), "assuming IAM Role (arn:aws:iam::222222222222:role/denied-workload) in assume_role 3 of 3"; !strings.HasPrefix(got, want) { //lintignore:AWSAT005
+		t.Errorf("error = %q, want prefix %q", got, want)
+	}
+}
@@ -13,8 +13,11 @@ import (
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/time/rate"
 )
 
 type AWSClient struct {
@@ -24,20 +27,79 @@ type AWSClient struct {
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
 	Partition               string
+	RandomnessSource        *create.RandomnessSource // Seeded source of unique identifiers, used when replaying AWS API interactions.
 	Region                  string
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicyConfig         *tftags.PolicyConfig
 	TerraformVersion        string
 
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	awsConfig          *aws_sdkv2.Config
+	clients            map[string]any
+	concurrencyLimiter *tfsync.Limiter // From provider configuration.
+	conns              map[string]any
+	endpoints          map[string]string // From provider configuration.
+	httpClient         *http.Client
+	lock               sync.Mutex
+	rateLimiters       map[string]*rate.Limiter // From provider configuration.
+	s3UsePathStyle     bool                     // From provider configuration.
+	stsRegion          string                   // From provider configuration.
+}
+
+// ResourceDefaultTagsConfig returns the default tags for the resource whose CRUD handler is running,
+// i.e. any provider default_tags scopes that include the resource have been applied.
+func (client *AWSClient) ResourceDefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
+	return client.DefaultTagsConfig
+}
+
+// ForRegion returns an AWSClient whose API clients are configured for the specified region.
+// As for the provider's configured region, API clients are created on first use.
+func (client *AWSClient) ForRegion(region string) *AWSClient {
+	if region == "" || region == client.Region {
+		return client
+	}
+
+	awsConfig := client.awsConfig
+	if awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+
+	session := client.Session
+	if session != nil {
+		session = session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+
+	return &AWSClient{
+		AccountID:         client.AccountID,
+		DefaultTagsConfig: client.DefaultTagsConfig,
+		DNSSuffix:         client.DNSSuffix,
+		IgnoreTagsConfig:  client.IgnoreTagsConfig,
+		Partition:         client.Partition,
+		RandomnessSource:  client.RandomnessSource,
+		Region:            region,
+		ReverseDNSPrefix:  client.ReverseDNSPrefix,
+		ServicePackages:   client.ServicePackages,
+		Session:           session,
+		TagPolicyConfig:   client.TagPolicyConfig,
+		TerraformVersion:  client.TerraformVersion,
+
+		awsConfig:          awsConfig,
+		clients:            make(map[string]any),
+		concurrencyLimiter: client.concurrencyLimiter,
+		conns:              make(map[string]any),
+		endpoints:          client.endpoints,
+		httpClient:         client.httpClient,
+		rateLimiters:       client.rateLimiters,
+		s3UsePathStyle:     client.s3UsePathStyle,
+		stsRegion:          client.stsRegion,
+	}
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -133,11 +195,22 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+	awsConfig, session := client.awsConfig, client.Session
+	if limiter, ok := client.rateLimiters[servicePackageName]; ok {
+		// All API clients for the service share the same rate limiter.
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(cfg.APIOptions, rateLimitSDKv2Middleware(limiter))
+		awsConfig = &cfg
+
+		session = session.Copy()
+		session.Handlers.Sign.PushFrontNamed(rateLimitSDKv1Handler(limiter))
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": client.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         client.endpoints[servicePackageName],
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -0,0 +1,41 @@
+package conns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// ConcurrencyLimitInterceptor returns an interceptor that limits the number of concurrent executions of the specified resource operation(s).
+// Executions over the limit wait for capacity instead of failing.
+// The limit is keyed by service package name and AWS API operation, e.g. `eks/CreateCluster`, and
+// defaults to the specified limit unless overridden in the provider's `concurrency_limits` configuration.
+// A default limit of 0 means that executions are only limited if so configured.
+func ConcurrencyLimitInterceptor(servicePackageName, operation string, defaultLimit int, why interceptor.Why) interceptor.SDKResourceItem {
+	key := tfsync.LimiterKey(servicePackageName, operation)
+
+	return interceptor.SDKResourceItem{
+		When: interceptor.Before | interceptor.Finally,
+		Why:  why,
+		Interceptor: interceptor.SDKResourceFunc(func(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			client, ok := meta.(*AWSClient)
+			if !ok || client.concurrencyLimiter == nil {
+				return ctx, diags
+			}
+
+			switch when {
+			case interceptor.Before:
+				if err := client.concurrencyLimiter.Acquire(ctx, key, defaultLimit); err != nil {
+					return ctx, sdkdiag.AppendFromErr(diags, err)
+				}
+			case interceptor.Finally:
+				client.concurrencyLimiter.Release(key)
+			}
+
+			return ctx, diags
+		}),
+	}
+}
@@ -0,0 +1,50 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		concurrencyLimiter: tfsync.NewLimiter(map[string]int{"eks/CreateCluster": 1}),
+	}
+	item := ConcurrencyLimitInterceptor("eks", "CreateCluster", 0, interceptor.Create)
+
+	if got, want := item.Why, interceptor.Create; got != want {
+		t.Errorf("Why = %v, want %v", got, want)
+	}
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	_, diags = item.Interceptor.Run(ctx, nil, client, interceptor.Before, interceptor.Create, diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// A second execution waits for the first.
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, diags = item.Interceptor.Run(waitCtx, nil, client, interceptor.Before, interceptor.Create, nil)
+	if !diags.HasError() {
+		t.Fatal("Second execution was able to start. This shouldn't happen.")
+	}
+
+	_, diags = item.Interceptor.Run(ctx, nil, client, interceptor.Finally, interceptor.Create, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	_, diags = item.Interceptor.Run(waitCtx, nil, client, interceptor.Before, interceptor.Create, nil)
+	if diags.HasError() {
+		t.Fatalf("Second execution blocked after the first finished. This shouldn't happen: %v", diags)
+	}
+}
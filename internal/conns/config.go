@@ -11,15 +11,19 @@ import (
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/time/rate"
 )
 
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole // Assumed in order
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -32,6 +36,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]RateLimit
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -43,6 +48,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -76,8 +82,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first IAM Role in an assume role chain is assumed with the base credentials.
+	if len(c.AssumeRole) > 0 {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -104,21 +111,33 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
+		if len(c.AssumeRole) > 1 && awsbase.IsCannotAssumeRoleError(err) {
+			err = &assumeRoleHopError{hop: 1, hops: len(c.AssumeRole), roleARN: c.AssumeRole[0].RoleARN, err: err}
+		}
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	// Any subsequent IAM Roles are assumed with the credentials of the previous role.
+	if len(c.AssumeRole) > 1 {
+		if err := assumeRoleChain(ctx, &cfg, c.AssumeRole[1:], 1, c.Endpoints[names.STS], c.STSRegion); err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
 		}
 	}
 	c.Region = cfg.Region
+	cfg.APIOptions = append(cfg.APIOptions, metrics.AddSDKv2Middleware)
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, err := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
+	metrics.AddSDKv1Handlers(&sess.Handlers)
 
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
@@ -165,13 +184,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.concurrencyLimiter = tfsync.NewLimiter(c.ConcurrencyLimits)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.rateLimiters = make(map[string]*rate.Limiter, len(c.RateLimits))
+	for k, v := range c.RateLimits {
+		client.rateLimiters[k] = newRateLimiter(v)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.stsRegion = c.STSRegion
 
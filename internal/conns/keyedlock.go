@@ -0,0 +1,189 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GlobalKeyedLock is the provider-wide KeyedLock.
+// It is used to serialize changes across arbitrary collaborators that share knowledge of the keys they must serialize on.
+var GlobalKeyedLock = NewKeyedLock()
+
+// KeyedLock is a store of reader/writer locks keyed by arbitrary strings.
+// Waiting for a lock can be cancelled via a Context or timeout.
+// A key's lock state is discarded once the lock has no holders or waiters.
+type KeyedLock struct {
+	lock  sync.Mutex
+	store map[string]*keyedLockEntry
+}
+
+// keyedLockEntry is a single key's lock state. All fields are guarded by the owning KeyedLock's lock.
+type keyedLockEntry struct {
+	refs           int           // Number of holders and waiters
+	readers        int           // Number of readers holding the lock
+	writer         bool          // Whether a writer holds the lock
+	writersWaiting int           // Number of writers waiting for the lock
+	changed        chan struct{} // Closed (and replaced) whenever the lock is released
+}
+
+// NewKeyedLock returns a new, empty KeyedLock.
+func NewKeyedLock() *KeyedLock {
+	return &KeyedLock{
+		store: make(map[string]*keyedLockEntry),
+	}
+}
+
+// Lock locks the specified key for writing (exclusive access).
+// If timeout is positive, waiting for the lock is abandoned after that duration.
+// An error is returned if the lock could not be acquired before the Context is done or the timeout expires.
+// The caller is responsible for calling Unlock for the same key if, and only if, no error is returned.
+func (m *KeyedLock) Lock(ctx context.Context, key string, timeout time.Duration) error {
+	return m.acquire(ctx, key, timeout, true)
+}
+
+// Unlock unlocks the specified key for writing.
+func (m *KeyedLock) Unlock(ctx context.Context, key string) {
+	m.release(ctx, key, true)
+}
+
+// RLock locks the specified key for reading (shared access).
+// Multiple readers can hold the lock at the same time. Readers wait for any waiting writers.
+// The caller is responsible for calling RUnlock for the same key if, and only if, no error is returned.
+func (m *KeyedLock) RLock(ctx context.Context, key string, timeout time.Duration) error {
+	return m.acquire(ctx, key, timeout, false)
+}
+
+// RUnlock unlocks the specified key for reading.
+func (m *KeyedLock) RUnlock(ctx context.Context, key string) {
+	m.release(ctx, key, false)
+}
+
+func (m *KeyedLock) acquire(ctx context.Context, key string, timeout time.Duration, write bool) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ctx = tflog.SetField(ctx, "tf_aws.keyed_lock.key", key)
+	ctx = tflog.SetField(ctx, "tf_aws.keyed_lock.write", write)
+	start := time.Now()
+
+	m.lock.Lock()
+	e, ok := m.store[key]
+	if !ok {
+		e = &keyedLockEntry{
+			changed: make(chan struct{}),
+		}
+		m.store[key] = e
+	}
+	e.refs++
+	if write {
+		e.writersWaiting++
+	}
+
+	for logged := false; ; logged = true {
+		if write && !e.writer && e.readers == 0 {
+			e.writersWaiting--
+			e.writer = true
+			break
+		}
+		if !write && !e.writer && e.writersWaiting == 0 {
+			e.readers++
+			break
+		}
+
+		if !logged {
+			tflog.Debug(ctx, "Waiting for lock")
+		}
+
+		changed := e.changed
+		m.lock.Unlock()
+
+		select {
+		case <-changed:
+			m.lock.Lock()
+		case <-ctx.Done():
+			m.lock.Lock()
+			if write {
+				e.writersWaiting--
+				// Readers may have been waiting only for this writer.
+				e.signal()
+			}
+			m.unref(key, e)
+			m.lock.Unlock()
+
+			err := ctx.Err()
+			tflog.Warn(ctx, "Abandoned waiting for lock", map[string]any{
+				"tf_aws.keyed_lock.wait": time.Since(start).String(),
+				"error":                  err.Error(),
+			})
+
+			return fmt.Errorf("acquiring lock (%s): %w", key, err)
+		}
+	}
+	m.lock.Unlock()
+
+	tflog.Debug(ctx, "Acquired lock", map[string]any{
+		"tf_aws.keyed_lock.wait": time.Since(start).String(),
+	})
+
+	return nil
+}
+
+func (m *KeyedLock) release(ctx context.Context, key string, write bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e, ok := m.store[key]
+	if !ok {
+		panic(fmt.Sprintf("unlock of unlocked key: %s", key))
+	}
+
+	if write {
+		if !e.writer {
+			panic(fmt.Sprintf("unlock of key not locked for writing: %s", key))
+		}
+		e.writer = false
+	} else {
+		if e.readers == 0 {
+			panic(fmt.Sprintf("unlock of key not locked for reading: %s", key))
+		}
+		e.readers--
+	}
+
+	e.signal()
+	m.unref(key, e)
+
+	tflog.Debug(ctx, "Released lock", map[string]any{
+		"tf_aws.keyed_lock.key":   key,
+		"tf_aws.keyed_lock.write": write,
+	})
+}
+
+// unref drops a reference to the specified key's lock state, discarding it when idle.
+// The caller must hold the lock.
+func (m *KeyedLock) unref(key string, e *keyedLockEntry) {
+	e.refs--
+	if e.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// len returns the number of keys with lock state.
+func (m *KeyedLock) len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return len(m.store)
+}
+
+// signal wakes all waiters. The caller must hold the owning KeyedLock's lock.
+func (e *keyedLockEntry) signal() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
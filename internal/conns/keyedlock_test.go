@@ -0,0 +1,232 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKeyedLockLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.Lock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		kl.Lock(ctx, "foo", 0) //nolint:errcheck // Can't fail without a deadline.
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+}
+
+func TestKeyedLockUnlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.Lock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+	kl.Unlock(ctx, "foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		kl.Lock(ctx, "foo", 0) //nolint:errcheck // Can't fail without a deadline.
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+}
+
+func TestKeyedLockDifferentKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.Lock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		kl.Lock(ctx, "bar", 0) //nolint:errcheck // Can't fail without a deadline.
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
+	}
+}
+
+func TestKeyedLockTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.Lock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	err := kl.Lock(ctx, "foo", 10*time.Millisecond)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	kl.Unlock(ctx, "foo")
+
+	if got, want := kl.len(), 0; got != want {
+		t.Errorf("len = %d, want %d", got, want)
+	}
+}
+
+func TestKeyedLockContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	kl := NewKeyedLock()
+
+	if err := kl.Lock(context.Background(), "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- kl.Lock(ctx, "foo", 0)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Lock not abandoned after Context cancelled. This shouldn't happen.")
+	}
+}
+
+func TestKeyedLockReaders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.RLock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := kl.RLock(ctx, "foo", 10*time.Millisecond); err != nil {
+		t.Fatalf("Second read lock blocked. This shouldn't happen: %s", err)
+	}
+
+	if err := kl.Lock(ctx, "foo", 10*time.Millisecond); err == nil {
+		t.Fatal("Write lock was able to be taken while read locked. This shouldn't happen.")
+	}
+
+	kl.RUnlock(ctx, "foo")
+	kl.RUnlock(ctx, "foo")
+
+	if err := kl.Lock(ctx, "foo", 10*time.Millisecond); err != nil {
+		t.Fatalf("Write lock blocked after read unlocks. This shouldn't happen: %s", err)
+	}
+
+	if err := kl.RLock(ctx, "foo", 10*time.Millisecond); err == nil {
+		t.Fatal("Read lock was able to be taken while write locked. This shouldn't happen.")
+	}
+
+	kl.Unlock(ctx, "foo")
+}
+
+func TestKeyedLockWaitingWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	if err := kl.RLock(ctx, "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	writerCh := make(chan struct{})
+
+	go func() {
+		kl.Lock(ctx, "foo", 0) //nolint:errcheck // Can't fail without a deadline.
+		close(writerCh)
+	}()
+
+	// Wait for the writer to queue.
+	time.Sleep(20 * time.Millisecond)
+
+	// New readers wait for the queued writer.
+	if err := kl.RLock(ctx, "foo", 10*time.Millisecond); err == nil {
+		t.Fatal("Read lock was able to be taken while a writer is waiting. This shouldn't happen.")
+	}
+
+	kl.RUnlock(ctx, "foo")
+
+	select {
+	case <-writerCh:
+		// pass
+	case <-time.After(time.Second):
+		t.Fatal("Writer blocked after read unlock. This shouldn't happen.")
+	}
+
+	kl.Unlock(ctx, "foo")
+
+	if got, want := kl.len(), 0; got != want {
+		t.Errorf("len = %d, want %d", got, want)
+	}
+}
+
+func TestKeyedLockIdleKeysDiscarded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kl := NewKeyedLock()
+
+	for _, key := range []string{"foo", "bar", "baz"} {
+		if err := kl.Lock(ctx, key, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := kl.len(), 3; got != want {
+		t.Errorf("len = %d, want %d", got, want)
+	}
+
+	for _, key := range []string{"foo", "bar", "baz"} {
+		kl.Unlock(ctx, key)
+	}
+
+	if got, want := kl.len(), 0; got != want {
+		t.Errorf("len = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,60 @@
+package conns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit represents client-side rate limiting of a service's AWS API calls.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// newRateLimiter returns a token bucket rate limiter for the specified rate limit.
+// The burst size defaults to the number of requests per second, rounded up, and is at least 1.
+func newRateLimiter(v RateLimit) *rate.Limiter {
+	burst := v.Burst
+	if burst <= 0 {
+		burst = int(v.RequestsPerSecond)
+		if float64(burst) < v.RequestsPerSecond {
+			burst++
+		}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return rate.NewLimiter(rate.Limit(v.RequestsPerSecond), burst)
+}
+
+// rateLimitSDKv1Handler returns an AWS SDK for Go v1 request handler that waits on the specified rate limiter.
+// The handler runs before each attempt is signed so that retries are also rate limited.
+func rateLimitSDKv1Handler(limiter *rate.Limiter) request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "tf_aws.RateLimit",
+		Fn: func(r *request_sdkv1.Request) {
+			if err := limiter.Wait(r.Context()); err != nil {
+				r.Error = awserr.New(request_sdkv1.CanceledErrorCode, "waiting for client-side rate limit", err)
+			}
+		},
+	}
+}
+
+// rateLimitSDKv2Middleware returns an AWS SDK for Go v2 API option that adds middleware that waits on the specified rate limiter.
+// The middleware runs after the retry middleware so that retries are also rate limited.
+func rateLimitSDKv2Middleware(limiter *rate.Limiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("tf_aws.RateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleFinalize(ctx, in)
+		}), "Retry", middleware.After)
+	}
+}
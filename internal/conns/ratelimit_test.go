@@ -0,0 +1,140 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ssm_sdkv1 "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         RateLimit
+		expectedLimit rate.Limit
+		expectedBurst int
+	}{
+		{
+			name:          "default burst",
+			input:         RateLimit{RequestsPerSecond: 10},
+			expectedLimit: 10,
+			expectedBurst: 10,
+		},
+		{
+			name:          "default burst rounded up",
+			input:         RateLimit{RequestsPerSecond: 2.5},
+			expectedLimit: 2.5,
+			expectedBurst: 3,
+		},
+		{
+			name:          "default burst minimum",
+			input:         RateLimit{RequestsPerSecond: 0.1},
+			expectedLimit: 0.1,
+			expectedBurst: 1,
+		},
+		{
+			name:          "burst",
+			input:         RateLimit{RequestsPerSecond: 5, Burst: 20},
+			expectedLimit: 5,
+			expectedBurst: 20,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := newRateLimiter(testCase.input)
+
+			if got, want := limiter.Limit(), testCase.expectedLimit; got != want {
+				t.Errorf("Limit = %v, want %v", got, want)
+			}
+			if got, want := limiter.Burst(), testCase.expectedBurst; got != want {
+				t.Errorf("Burst = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func newSSMStubServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"Parameter":{"Name":"test","Value":"value"}}`)) //nolint:errcheck // Test stub.
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// Three calls at 20 requests per second with a burst of 1 take at least 100ms.
+const (
+	testRateLimitCalls       = 3
+	testRateLimitMinDuration = 100 * time.Millisecond
+)
+
+func TestRateLimitSDKv1Handler(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session.NewSession(&aws_sdkv1.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws_sdkv1.String(newSSMStubServer(t).URL),
+		Region:      aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess.Handlers.Sign.PushFrontNamed(rateLimitSDKv1Handler(rate.NewLimiter(20, 1)))
+	conn := ssm_sdkv1.New(sess)
+
+	start := time.Now()
+	for i := 0; i < testRateLimitCalls; i++ {
+		if _, err := conn.GetParameter(&ssm_sdkv1.GetParameterInput{Name: aws_sdkv1.String("test")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < testRateLimitMinDuration {
+		t.Errorf("elapsed = %s, want at least %s", elapsed, testRateLimitMinDuration)
+	}
+}
+
+func TestRateLimitSDKv2Middleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := ssm_sdkv2.New(ssm_sdkv2.Options{
+		APIOptions: []func(*middleware.Stack) error{rateLimitSDKv2Middleware(rate.NewLimiter(20, 1))},
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		}),
+		EndpointResolver: ssm_sdkv2.EndpointResolverFromURL(newSSMStubServer(t).URL),
+		Region:           "us-west-2", //lintignore:AWSAT003
+	})
+
+	start := time.Now()
+	for i := 0; i < testRateLimitCalls; i++ {
+		if _, err := client.GetParameter(ctx, &ssm_sdkv2.GetParameterInput{Name: aws_sdkv2.String("test")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < testRateLimitMinDuration {
+		t.Errorf("elapsed = %s, want at least %s", elapsed, testRateLimitMinDuration)
+	}
+}
@@ -1,6 +1,7 @@
 package create
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -8,22 +9,22 @@ import (
 )
 
 // Name returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with terraform-
-func Name(name string, namePrefix string) string {
-	return NameWithSuffix(name, namePrefix, "")
+func Name(ctx context.Context, name string, namePrefix string) string {
+	return NameWithSuffix(ctx, name, namePrefix, "")
 }
 
 // NameWithSuffix returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with "terraform-".
 // In the latter two cases, any suffix is appended to the generated name
-func NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
+func NameWithSuffix(ctx context.Context, name string, namePrefix string, nameSuffix string) string {
 	if name != "" {
 		return name
 	}
 
 	if namePrefix != "" {
-		return id.PrefixedUniqueId(namePrefix) + nameSuffix
+		return PrefixedUniqueId(ctx, namePrefix) + nameSuffix
 	}
 
-	return id.UniqueId() + nameSuffix
+	return UniqueId(ctx) + nameSuffix
 }
 
 // hasResourceUniqueIDPlusAdditionalSuffix returns true if the string has the built-in unique ID suffix plus an additional suffix
@@ -0,0 +1,68 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// RandomnessSource is a goroutine-safe, seeded source of provider-generated unique identifiers.
+// It is used when replaying recorded AWS API interactions so that generated names
+// and idempotency tokens are identical to those recorded.
+type RandomnessSource struct {
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+// NewRandomnessSource returns a RandomnessSource seeded with the specified value.
+func NewRandomnessSource(seed int64) *RandomnessSource {
+	return &RandomnessSource{
+		rand: rand.New(rand.NewSource(seed)), //nolint:gosec // Deterministic output is required.
+	}
+}
+
+// suffix returns a unique ID suffix of length id.UniqueIDSuffixLength.
+func (s *RandomnessSource) suffix() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return fmt.Sprintf("%018x%08x", uint64(s.rand.Int63()), uint32(s.rand.Int63()))
+}
+
+// NewRandomnessContext returns a Context enhanced with a seeded source of unique identifiers.
+func NewRandomnessContext(ctx context.Context, source *RandomnessSource) context.Context {
+	if source == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, randomnessKey, source)
+}
+
+func randomnessFromContext(ctx context.Context) (*RandomnessSource, bool) {
+	v, ok := ctx.Value(randomnessKey).(*RandomnessSource)
+	return v, ok
+}
+
+type randomnessKeyType int
+
+var randomnessKey randomnessKeyType
+
+// UniqueId returns a unique identifier with the default "terraform-" prefix.
+// It is the replacement for the Plugin SDK's id.UniqueId.
+func UniqueId(ctx context.Context) string {
+	return PrefixedUniqueId(ctx, id.UniqueIdPrefix)
+}
+
+// PrefixedUniqueId returns a unique identifier with the specified prefix.
+// It is the replacement for the Plugin SDK's id.PrefixedUniqueId.
+// If the Context contains a seeded source (see NewRandomnessContext) the identifier is generated deterministically.
+func PrefixedUniqueId(ctx context.Context, prefix string) string {
+	if v, ok := randomnessFromContext(ctx); ok {
+		return prefix + v.suffix()
+	}
+
+	return id.PrefixedUniqueId(prefix)
+}
@@ -0,0 +1,47 @@
+package create
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+func TestUniqueId(t *testing.T) {
+	t.Parallel()
+
+	re := regexp.MustCompile(`^terraform-[[:xdigit:]]{26}$`)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		NewRandomnessContext(context.Background(), NewRandomnessSource(42)),
+	} {
+		if got := UniqueId(ctx); !re.MatchString(got) {
+			t.Errorf("UniqueId = %q, want match %q", got, re)
+		}
+	}
+}
+
+func TestPrefixedUniqueIdSeeded(t *testing.T) {
+	t.Parallel()
+
+	ctx1 := NewRandomnessContext(context.Background(), NewRandomnessSource(42))
+	ctx2 := NewRandomnessContext(context.Background(), NewRandomnessSource(42))
+
+	for i := 0; i < 3; i++ {
+		got1, got2 := PrefixedUniqueId(ctx1, "test-"), PrefixedUniqueId(ctx2, "test-")
+
+		if got1 != got2 {
+			t.Errorf("PrefixedUniqueId = %q, %q, want equal", got1, got2)
+		}
+
+		if got, want := len(got1), len("test-")+id.UniqueIDSuffixLength; got != want {
+			t.Errorf("len(PrefixedUniqueId) = %d, want %d", got, want)
+		}
+
+		if NamePrefixFromName(got1) == nil {
+			t.Errorf("NamePrefixFromName(%q) = nil", got1)
+		}
+	}
+}
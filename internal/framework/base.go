@@ -59,7 +59,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.Meta().ResourceDefaultTagsConfig(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -75,6 +75,18 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			resourceTags := tftags.New(ctx, planTags)
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+			if tagPolicyConfig := r.Meta().TagPolicyConfig; tagPolicyConfig != nil {
+				if err := tagPolicyConfig.Evaluate(allTags); err != nil {
+					if tagPolicyConfig.Warn() {
+						response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag policy violation", err.Error())
+					} else {
+						response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", err.Error())
+
+						return
+					}
+				}
+			}
+
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
@@ -0,0 +1,135 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// walkStructFields traverses `from` calling `visitor` for each exported field.
+func walkStructFields(ctx context.Context, from any, to any, visitor fieldVisitor) error {
+	valFrom, valTo := reflect.ValueOf(from), reflect.ValueOf(to)
+
+	if kind := valFrom.Kind(); kind == reflect.Ptr {
+		valFrom = valFrom.Elem()
+	}
+	if kind := valTo.Kind(); kind != reflect.Ptr {
+		return fmt.Errorf("target (%T): %s, want pointer", to, kind)
+	}
+	valTo = valTo.Elem()
+
+	typFrom, typTo := valFrom.Type(), valTo.Type()
+
+	if typFrom.Kind() != reflect.Struct {
+		return fmt.Errorf("source: %s, want struct", typFrom)
+	}
+	if typTo.Kind() != reflect.Struct {
+		return fmt.Errorf("target: %s, want struct", typTo)
+	}
+
+	return walkStructValues(ctx, valFrom, valTo, visitor)
+}
+
+// walkStructValues traverses the struct value `valFrom` calling `visitor` for each exported field
+// that has a corresponding settable field in the struct value `valTo`.
+func walkStructValues(ctx context.Context, valFrom, valTo reflect.Value, visitor fieldVisitor) error {
+	typFrom := valFrom.Type()
+
+	for i := 0; i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if fieldName == "Tags" {
+			continue // Resource tags are handled separately.
+		}
+		toFieldVal := findFieldFuzzy(fieldName, valTo)
+		if !toFieldVal.IsValid() {
+			continue // Corresponding field not found in to.
+		}
+		if !toFieldVal.CanSet() {
+			continue // Corresponding field value can't be changed.
+		}
+		if err := visitor.visit(ctx, fieldName, valFrom.Field(i), toFieldVal); err != nil {
+			return fmt.Errorf("visit (%s): %w", fieldName, err)
+		}
+	}
+
+	return nil
+}
+
+type fieldVisitor interface {
+	visit(context.Context, string, reflect.Value, reflect.Value) error
+}
+
+// findFieldFuzzy returns the field of the struct value `valTo` corresponding to `fieldName`.
+// An exact match is preferred, otherwise names are compared case-insensitively,
+// ignoring underscores and tolerating plural/singular differences.
+// The zero Value is returned if there is no corresponding field.
+func findFieldFuzzy(fieldName string, valTo reflect.Value) reflect.Value {
+	if v := valTo.FieldByName(fieldName); v.IsValid() {
+		return v
+	}
+
+	typTo := valTo.Type()
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if fieldNamesMatch(fieldName, field.Name) {
+			return valTo.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// fieldNamesMatch returns whether or not two field or attribute names should be considered equivalent.
+// For example "SecurityGroupIds", "security_group_id" and "SecurityGroupIDs" all match.
+func fieldNamesMatch(a, b string) bool {
+	a, b = normalizeFieldName(a), normalizeFieldName(b)
+
+	if a == b {
+		return true
+	}
+
+	for _, v := range nameVariants(a) {
+		if v == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeFieldName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// nameVariants returns the singular and plural variants of the normalized name `s`.
+func nameVariants(s string) []string {
+	var variants []string
+
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		variants = append(variants, strings.TrimSuffix(s, "ies")+"y")
+	case strings.HasSuffix(s, "ses"), strings.HasSuffix(s, "xes"):
+		variants = append(variants, strings.TrimSuffix(s, "es"))
+	case strings.HasSuffix(s, "s"):
+		variants = append(variants, strings.TrimSuffix(s, "s"))
+	}
+
+	switch {
+	case strings.HasSuffix(s, "y"):
+		variants = append(variants, strings.TrimSuffix(s, "y")+"ies")
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"):
+		variants = append(variants, s+"es")
+	default:
+		variants = append(variants, s+"s")
+	}
+
+	return variants
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 // Expand "expands" a resource's "business logic" data structure,
@@ -16,6 +18,9 @@ import (
 // The resource's data structure is walked and exported fields that
 // have a corresponding field in the API data structure (and a suitable
 // target data type) are copied.
+//
+// Nested blocks may be modeled either as framework List, Set or Object values
+// or as Go structs (or pointers or slices of structs) whose fields are framework values.
 func Expand(ctx context.Context, tfObject, apiObject any) error {
 	if err := walkStructFields(ctx, tfObject, apiObject, expandVisitor{}); err != nil {
 		return fmt.Errorf("Expand[%T, %T]: %w", tfObject, apiObject, err)
@@ -24,170 +29,239 @@ func Expand(ctx context.Context, tfObject, apiObject any) error {
 	return nil
 }
 
-// walkStructFields traverses `from` calling `visitor` for each exported field.
-func walkStructFields(ctx context.Context, from any, to any, visitor fieldVisitor) error {
-	valFrom, valTo := reflect.ValueOf(from), reflect.ValueOf(to)
-
-	if kind := valFrom.Kind(); kind == reflect.Ptr {
-		valFrom = valFrom.Elem()
-	}
-	if kind := valTo.Kind(); kind != reflect.Ptr {
-		return fmt.Errorf("target (%T): %s, want pointer", to, kind)
-	}
-	valTo = valTo.Elem()
-
-	typFrom, typTo := valFrom.Type(), valTo.Type()
+type expandVisitor struct{}
 
-	if typFrom.Kind() != reflect.Struct {
-		return fmt.Errorf("source: %s, want struct", typFrom)
-	}
-	if typTo.Kind() != reflect.Struct {
-		return fmt.Errorf("target: %s, want struct", typTo)
+func (v expandVisitor) visit(ctx context.Context, fieldName string, valFrom, valTo reflect.Value) error {
+	if vFrom, ok := valFrom.Interface().(attr.Value); ok {
+		return v.expandValue(ctx, vFrom, valTo)
 	}
 
-	for i := 0; i < typFrom.NumField(); i++ {
-		field := typFrom.Field(i)
-		if field.PkgPath != "" {
-			continue // Skip unexported fields.
-		}
-		fieldName := field.Name
-		if fieldName == "Tags" {
-			continue // Resource tags are handled separately.
-		}
-		toFieldVal := valTo.FieldByName(fieldName)
-		if !toFieldVal.IsValid() {
-			continue // Corresponding field not found in to.
-		}
-		if !toFieldVal.CanSet() {
-			continue // Corresponding field value can't be changed.
-		}
-		if err := visitor.visit(ctx, fieldName, valFrom.Field(i), toFieldVal); err != nil {
-			return fmt.Errorf("visit (%s): %w", fieldName, err)
-		}
+	if isNestedStructKind(valFrom.Type()) {
+		return v.expandNested(ctx, valFrom, valTo)
 	}
 
-	return nil
-}
-
-type fieldVisitor interface {
-	visit(context.Context, string, reflect.Value, reflect.Value) error
+	return fmt.Errorf("does not implement attr.Value: %s", valFrom.Kind())
 }
 
-type expandVisitor struct{}
-
-func (v expandVisitor) visit(ctx context.Context, fieldName string, valFrom, valTo reflect.Value) error {
-	vFrom, ok := valFrom.Interface().(attr.Value)
-	if !ok {
-		return fmt.Errorf("does not implement attr.Value: %s", valFrom.Kind())
-	}
-
+// expandValue copies the framework value `vFrom` into `valTo`.
+func (v expandVisitor) expandValue(ctx context.Context, vFrom attr.Value, valTo reflect.Value) error {
 	// No need to set the target value if there's no source value.
 	if vFrom.IsNull() || vFrom.IsUnknown() {
 		return nil
 	}
 
 	tFrom, kTo := vFrom.Type(ctx), valTo.Kind()
-	switch {
+
+	// Allocate and populate pointer targets.
+	if kTo == reflect.Ptr {
+		ptr := reflect.New(valTo.Type().Elem())
+		if err := v.expandValue(ctx, vFrom, ptr.Elem()); err != nil {
+			return err
+		}
+		valTo.Set(ptr)
+		return nil
+	}
+
+	switch vFrom := vFrom.(type) {
 	// Simple types.
-	case tFrom.Equal(types.BoolType):
-		vFrom := vFrom.(types.Bool).ValueBool()
+	case types.Bool:
 		switch kTo {
 		case reflect.Bool:
-			valTo.SetBool(vFrom)
+			valTo.SetBool(vFrom.ValueBool())
 			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Bool:
-				valTo.Set(reflect.ValueOf(aws.Bool(vFrom)))
-				return nil
-			}
 		}
 
-	case tFrom.Equal(types.Float64Type):
-		vFrom := vFrom.(types.Float64).ValueFloat64()
+	case types.Float64:
 		switch kTo {
 		case reflect.Float32, reflect.Float64:
-			valTo.SetFloat(vFrom)
+			valTo.SetFloat(vFrom.ValueFloat64())
 			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Float32:
-				valTo.Set(reflect.ValueOf(aws.Float32(float32(vFrom))))
-				return nil
-			case reflect.Float64:
-				valTo.Set(reflect.ValueOf(aws.Float64(vFrom)))
-				return nil
-			}
 		}
 
-	case tFrom.Equal(types.Int64Type):
-		vFrom := vFrom.(types.Int64).ValueInt64()
+	case types.Int64:
 		switch kTo {
 		case reflect.Int32, reflect.Int64:
-			valTo.SetInt(vFrom)
+			valTo.SetInt(vFrom.ValueInt64())
 			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Int32:
-				valTo.Set(reflect.ValueOf(aws.Int32(int32(vFrom))))
-				return nil
-			case reflect.Int64:
-				valTo.Set(reflect.ValueOf(aws.Int64(vFrom)))
-				return nil
-			}
 		}
 
-	case tFrom.Equal(types.StringType):
-		vFrom := vFrom.(types.String).ValueString()
+	case fwtypes.TimestampValue:
+		switch valTo.Type() {
+		case reflect.TypeOf(time.Time{}):
+			valTo.Set(reflect.ValueOf(vFrom.ValueTimestamp()))
+			return nil
+		}
 		switch kTo {
 		case reflect.String:
-			valTo.SetString(vFrom)
+			valTo.SetString(vFrom.ValueString())
 			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(aws.String(vFrom)))
-				return nil
-			}
 		}
 
-		// Aggregate types.
-	case tFrom.Equal(types.ListType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.List)
+	// String values, including custom string types such as ARNs.
+	// String enum types (~string) are handled by reflection.
+	case basetypes.StringValuable:
+		vString, diags := vFrom.ToStringValue(ctx)
+		if diags.HasError() {
+			return fmt.Errorf("converting to string: %v", diags)
+		}
 		switch kTo {
-		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueList(ctx, vFrom)))
-				return nil
+		case reflect.String:
+			valTo.SetString(vString.ValueString())
+			return nil
+		}
+
+	// Aggregate types.
+	case types.List:
+		return v.expandElements(ctx, tFrom, vFrom.Elements(), valTo)
 
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringList(ctx, vFrom)))
-					return nil
+	case types.Set:
+		return v.expandElements(ctx, tFrom, vFrom.Elements(), valTo)
+
+	case types.Map:
+		switch kTo {
+		case reflect.Map:
+			tMap := valTo.Type()
+			if tMap.Key().Kind() != reflect.String {
+				break
+			}
+			m := reflect.MakeMapWithSize(tMap, len(vFrom.Elements()))
+			for key, elem := range vFrom.Elements() {
+				val := reflect.New(tMap.Elem()).Elem()
+				if err := v.expandValue(ctx, elem, val); err != nil {
+					return err
 				}
+				m.SetMapIndex(reflect.ValueOf(key).Convert(tMap.Key()), val)
 			}
+			valTo.Set(m)
+			return nil
 		}
 
-	case tFrom.Equal(types.SetType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.Set)
+	case types.Object:
+		switch kTo {
+		case reflect.Struct:
+			return v.expandObject(ctx, vFrom, valTo)
+		}
+	}
+
+	return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+}
+
+// expandElements copies the elements of a framework List or Set value into `valTo`.
+// A slice target receives all elements; a struct target (a nested block with at most one element) receives the first.
+func (v expandVisitor) expandElements(ctx context.Context, tFrom attr.Type, elems []attr.Value, valTo reflect.Value) error {
+	switch kTo := valTo.Kind(); kTo {
+	case reflect.Slice:
+		s := reflect.MakeSlice(valTo.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := v.expandValue(ctx, elem, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		valTo.Set(s)
+		return nil
+
+	case reflect.Struct:
+		if len(elems) == 0 {
+			return nil
+		}
+		return v.expandValue(ctx, elems[0], valTo)
+
+	default:
+		return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+	}
+}
+
+// expandObject copies the attributes of a framework Object value into the corresponding fields of the struct value `valTo`.
+func (v expandVisitor) expandObject(ctx context.Context, vFrom types.Object, valTo reflect.Value) error {
+	attrs := vFrom.Attributes()
+	typTo := valTo.Type()
+
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldVal := valTo.Field(i)
+		if !fieldVal.CanSet() {
+			continue
+		}
+		for name, attrVal := range attrs {
+			if !fieldNamesMatch(name, field.Name) {
+				continue
+			}
+			if err := v.expandValue(ctx, attrVal, fieldVal); err != nil {
+				return fmt.Errorf("attribute (%s): %w", name, err)
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// expandNested copies a nested Go struct, pointer to struct or slice of structs whose fields are framework values into `valTo`.
+func (v expandVisitor) expandNested(ctx context.Context, valFrom, valTo reflect.Value) error {
+	kFrom, kTo := valFrom.Kind(), valTo.Kind()
+
+	switch kFrom {
+	case reflect.Ptr:
+		if valFrom.IsNil() {
+			return nil
+		}
+		return v.expandNested(ctx, valFrom.Elem(), valTo)
+
+	case reflect.Slice:
+		if valFrom.IsNil() {
+			return nil
+		}
 		switch kTo {
 		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueSet(ctx, vFrom)))
+			n := valFrom.Len()
+			s := reflect.MakeSlice(valTo.Type(), n, n)
+			for i := 0; i < n; i++ {
+				if err := v.expandNested(ctx, valFrom.Index(i), s.Index(i)); err != nil {
+					return err
+				}
+			}
+			valTo.Set(s)
+			return nil
+
+		case reflect.Ptr, reflect.Struct:
+			if valFrom.Len() == 0 {
 				return nil
+			}
+			return v.expandNested(ctx, valFrom.Index(0), valTo)
+		}
 
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringSet(ctx, vFrom)))
-					return nil
-				}
+	case reflect.Struct:
+		switch kTo {
+		case reflect.Ptr:
+			if valTo.Type().Elem().Kind() != reflect.Struct {
+				break
+			}
+			ptr := reflect.New(valTo.Type().Elem())
+			if err := walkStructValues(ctx, valFrom, ptr.Elem(), v); err != nil {
+				return err
 			}
+			valTo.Set(ptr)
+			return nil
+
+		case reflect.Struct:
+			return walkStructValues(ctx, valFrom, valTo, v)
 		}
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+	return fmt.Errorf("incompatible (%s): %s", valFrom.Type(), kTo)
+}
+
+// isNestedStructKind returns whether or not the type is a struct, pointer to struct or slice of (pointers to) structs.
+func isNestedStructKind(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return isNestedStructKind(typ.Elem())
+	case reflect.Struct:
+		return true
+	default:
+		return false
+	}
 }
@@ -3,11 +3,13 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestExpand struct{}
@@ -92,11 +94,91 @@ type UTestExpand struct {
 	Names types.List
 }
 
+type TestEnum string
+
+type VTestExpand struct {
+	Name TestEnum
+}
+
+type WTestExpand struct {
+	Name *TestEnum
+}
+
+type XTestExpand struct {
+	Names []TestEnum
+}
+
+type YTestExpand struct {
+	Labels types.Map
+}
+
+type ZTestExpand struct {
+	Labels map[string]string
+}
+
+type AATestExpand struct {
+	Labels map[string]*string
+}
+
+type ABTestExpand struct {
+	CreationTime fwtypes.TimestampValue
+}
+
+type ACTestExpand struct {
+	CreationTime time.Time
+}
+
+type ADTestExpand struct {
+	CreationTime *time.Time
+}
+
+type AETestExpand struct {
+	Config types.List
+}
+
+type AFTestExpand struct {
+	Config *BTestExpandNested
+}
+
+type AGTestExpand struct {
+	Configs []BTestExpandNested
+}
+
+type AHTestExpand struct {
+	Configs []*BTestExpandNested
+}
+
+type AITestExpand struct {
+	Config []ATestExpandNested
+}
+
+type AJTestExpand struct {
+	Config *ATestExpandNested
+}
+
+type ATestExpandNested struct {
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	Name     types.String `tfsdk:"name"`
+}
+
+type BTestExpandNested struct {
+	MaxItems *int32
+	Name     string
+}
+
 func TestGenericExpand(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testTime := time.Date(2023, time.June, 7, 15, 11, 34, 0, time.UTC)
+	testObjectType := types.ObjectType{AttrTypes: AttributeTypesMust[ATestExpandNested](ctx)}
+	testObjectList := types.ListValueMust(testObjectType, []attr.Value{
+		types.ObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+			"max_items": types.Int64Value(5),
+			"name":      types.StringValue("a"),
+		}),
+	})
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -264,6 +346,84 @@ func TestGenericExpand(t *testing.T) {
 			Target:     &TTestExpand{},
 			WantTarget: &TTestExpand{Names: aws.StringSlice([]string{"a"})},
 		},
+		{
+			TestName:   "single string Source and single enum Target",
+			Source:     &BTestExpand{Name: types.StringValue("a")},
+			Target:     &VTestExpand{},
+			WantTarget: &VTestExpand{Name: TestEnum("a")},
+		},
+		{
+			TestName:   "single string Source and single *enum Target",
+			Source:     &BTestExpand{Name: types.StringValue("a")},
+			Target:     &WTestExpand{},
+			WantTarget: &WTestExpand{Name: testEnumPointer("a")},
+		},
+		{
+			TestName:   "single list Source and single enum slice Target",
+			Source:     &UTestExpand{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
+			Target:     &XTestExpand{},
+			WantTarget: &XTestExpand{Names: []TestEnum{"a"}},
+		},
+		{
+			TestName:   "single map Source and single string map Target",
+			Source:     &YTestExpand{Labels: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+			Target:     &ZTestExpand{},
+			WantTarget: &ZTestExpand{Labels: map[string]string{"k": "v"}},
+		},
+		{
+			TestName:   "single map Source and single *string map Target",
+			Source:     &YTestExpand{Labels: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+			Target:     &AATestExpand{},
+			WantTarget: &AATestExpand{Labels: map[string]*string{"k": aws.String("v")}},
+		},
+		{
+			TestName:   "single timestamp Source and single time Target",
+			Source:     &ABTestExpand{CreationTime: fwtypes.NewTimestampValue(testTime)},
+			Target:     &ACTestExpand{},
+			WantTarget: &ACTestExpand{CreationTime: testTime},
+		},
+		{
+			TestName:   "single timestamp Source and single *time Target",
+			Source:     &ABTestExpand{CreationTime: fwtypes.NewTimestampValue(testTime)},
+			Target:     &ADTestExpand{},
+			WantTarget: &ADTestExpand{CreationTime: aws.Time(testTime)},
+		},
+		{
+			TestName:   "single null timestamp Source and single *time Target",
+			Source:     &ABTestExpand{CreationTime: fwtypes.NewTimestampNull()},
+			Target:     &ADTestExpand{},
+			WantTarget: &ADTestExpand{},
+		},
+		{
+			TestName:   "nested object list Source and single struct pointer Target",
+			Source:     &AETestExpand{Config: testObjectList},
+			Target:     &AFTestExpand{},
+			WantTarget: &AFTestExpand{Config: &BTestExpandNested{MaxItems: aws.Int32(5), Name: "a"}},
+		},
+		{
+			TestName:   "nested object list Source and struct slice Target",
+			Source:     &AETestExpand{Config: testObjectList},
+			Target:     &AGTestExpand{},
+			WantTarget: &AGTestExpand{Configs: []BTestExpandNested{{MaxItems: aws.Int32(5), Name: "a"}}},
+		},
+		{
+			TestName:   "nested struct slice Source and struct pointer slice Target",
+			Source:     &AITestExpand{Config: []ATestExpandNested{{MaxItems: types.Int64Value(5), Name: types.StringValue("a")}}},
+			Target:     &AHTestExpand{},
+			WantTarget: &AHTestExpand{Configs: []*BTestExpandNested{{MaxItems: aws.Int32(5), Name: "a"}}},
+		},
+		{
+			TestName:   "nested struct pointer Source and struct pointer Target",
+			Source:     &AJTestExpand{Config: &ATestExpandNested{MaxItems: types.Int64Value(5), Name: types.StringValue("a")}},
+			Target:     &AFTestExpand{},
+			WantTarget: &AFTestExpand{Config: &BTestExpandNested{MaxItems: aws.Int32(5), Name: "a"}},
+		},
+		{
+			TestName:   "nil nested struct pointer Source and struct pointer Target",
+			Source:     &AJTestExpand{},
+			Target:     &AFTestExpand{},
+			WantTarget: &AFTestExpand{},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -288,3 +448,8 @@ func TestGenericExpand(t *testing.T) {
 		})
 	}
 }
+
+func testEnumPointer(s string) *TestEnum {
+	v := TestEnum(s)
+	return &v
+}
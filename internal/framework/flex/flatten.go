@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -16,6 +18,11 @@ import (
 // The API data structure's fields are walked and exported fields that
 // have a corresponding field in the resource's data structure (and a
 // suitable target data type) are copied.
+//
+// Nested blocks may be modeled either as framework List, Set or Object values
+// or as Go structs (or pointers or slices of structs) whose fields are framework values.
+// A framework List, Set or Map target of a nested block must be initialized with its element type
+// (for example, types.ListNull(types.ObjectType{AttrTypes: ...})) so that the element type is known.
 func Flatten(ctx context.Context, apiObject, tfObject any) error {
 	if err := walkStructFields(ctx, apiObject, tfObject, flattenVisitor{}); err != nil {
 		return fmt.Errorf("Flatten[%T, %T]: %w", apiObject, tfObject, err)
@@ -27,138 +34,288 @@ func Flatten(ctx context.Context, apiObject, tfObject any) error {
 type flattenVisitor struct{}
 
 func (v flattenVisitor) visit(ctx context.Context, fieldName string, valFrom, valTo reflect.Value) error {
-	vTo, ok := valTo.Interface().(attr.Value)
-	if !ok {
-		return fmt.Errorf("does not implement attr.Value: %s", valTo.Kind())
+	if vTo, ok := valTo.Interface().(attr.Value); ok {
+		vFrom, err := v.flattenValue(ctx, valFrom, vTo.Type(ctx))
+		if err != nil {
+			return err
+		}
+		val := reflect.ValueOf(vFrom)
+		if !val.Type().AssignableTo(valTo.Type()) {
+			return fmt.Errorf("incompatible (%s): %s", val.Type(), valTo.Type())
+		}
+		valTo.Set(val)
+		return nil
+	}
+
+	if isNestedStructKind(valTo.Type()) {
+		return v.flattenNested(ctx, valFrom, valTo)
 	}
 
-	kFrom, tTo := valFrom.Kind(), vTo.Type(ctx)
+	return fmt.Errorf("does not implement attr.Value: %s", valTo.Kind())
+}
+
+// flattenValue returns the framework value of type `tTo` corresponding to `valFrom`.
+func (v flattenVisitor) flattenValue(ctx context.Context, valFrom reflect.Value, tTo attr.Type) (attr.Value, error) {
+	kFrom := valFrom.Kind()
+
 	switch kFrom {
 	case reflect.Bool:
-		vFrom := valFrom.Bool()
 		switch {
 		case tTo.Equal(types.BoolType):
-			valTo.Set(reflect.ValueOf(types.BoolValue(vFrom)))
-			return nil
+			return types.BoolValue(valFrom.Bool()), nil
 		}
 
 	case reflect.Float32, reflect.Float64:
-		vFrom := valFrom.Float()
 		switch {
 		case tTo.Equal(types.Float64Type):
-			valTo.Set(reflect.ValueOf(types.Float64Value(vFrom)))
-			return nil
+			return types.Float64Value(valFrom.Float()), nil
 		}
 
 	case reflect.Int32, reflect.Int64:
-		vFrom := valFrom.Int()
 		switch {
 		case tTo.Equal(types.Int64Type):
-			valTo.Set(reflect.ValueOf(types.Int64Value(vFrom)))
-			return nil
+			return types.Int64Value(valFrom.Int()), nil
 		}
 
+	// String and string enum (~string) types.
 	case reflect.String:
-		vFrom := valFrom.String()
-		switch {
-		case tTo.Equal(types.StringType):
-			valTo.Set(reflect.ValueOf(types.StringValue(vFrom)))
-			return nil
+		return flattenString(ctx, valFrom.String(), tTo, kFrom)
+
+	case reflect.Struct:
+		if valFrom.Type() == reflect.TypeOf(time.Time{}) {
+			return flattenString(ctx, valFrom.Interface().(time.Time).Format(time.RFC3339), tTo, kFrom)
 		}
 
-	case reflect.Ptr:
-		vFrom := valFrom.Elem()
-		switch valFrom.Type().Elem().Kind() {
-		case reflect.Bool:
-			switch {
-			case tTo.Equal(types.BoolType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.BoolValue(vFrom.Bool())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.BoolNull()))
+		switch tTo := tTo.(type) {
+		case basetypes.ObjectType:
+			return v.flattenObject(ctx, valFrom, tTo)
+
+		// A nested block with at most one element.
+		case basetypes.ListType:
+			if tElem, ok := tTo.ElemType.(basetypes.ObjectType); ok {
+				elem, err := v.flattenObject(ctx, valFrom, tElem)
+				if err != nil {
+					return nil, err
 				}
-				return nil
+				return types.ListValueMust(tElem, []attr.Value{elem}), nil
 			}
 
-		case reflect.Float32, reflect.Float64:
-			switch {
-			case tTo.Equal(types.Float64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Float64Value(vFrom.Float())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Float64Null()))
+		case basetypes.SetType:
+			if tElem, ok := tTo.ElemType.(basetypes.ObjectType); ok {
+				elem, err := v.flattenObject(ctx, valFrom, tElem)
+				if err != nil {
+					return nil, err
 				}
-				return nil
+				return types.SetValueMust(tElem, []attr.Value{elem}), nil
 			}
+		}
 
-		case reflect.Int32, reflect.Int64:
-			switch {
-			case tTo.Equal(types.Int64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Int64Value(vFrom.Int())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Int64Null()))
-				}
-				return nil
+	case reflect.Ptr:
+		if valFrom.IsNil() {
+			return nullValue(ctx, tTo)
+		}
+		return v.flattenValue(ctx, valFrom.Elem(), tTo)
+
+	case reflect.Slice:
+		// A nil or empty slice is converted to a null List or Set.
+		switch tTo := tTo.(type) {
+		case basetypes.ListType:
+			tElem := elementType(tTo.ElemType, valFrom.Type().Elem())
+			if valFrom.Len() == 0 {
+				return types.ListNull(tElem), nil
+			}
+			elems, err := v.flattenElements(ctx, valFrom, tElem)
+			if err != nil {
+				return nil, err
 			}
+			return types.ListValueMust(tElem, elems), nil
 
-		case reflect.String:
-			switch {
-			case tTo.Equal(types.StringType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.StringValue(vFrom.String())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.StringNull()))
+		case basetypes.SetType:
+			tElem := elementType(tTo.ElemType, valFrom.Type().Elem())
+			if valFrom.Len() == 0 {
+				return types.SetNull(tElem), nil
+			}
+			elems, err := v.flattenElements(ctx, valFrom, tElem)
+			if err != nil {
+				return nil, err
+			}
+			return types.SetValueMust(tElem, elems), nil
+		}
+
+	case reflect.Map:
+		switch tTo := tTo.(type) {
+		case basetypes.MapType:
+			if valFrom.Type().Key().Kind() != reflect.String {
+				break
+			}
+			tElem := elementType(tTo.ElemType, valFrom.Type().Elem())
+			if valFrom.IsNil() {
+				return types.MapNull(tElem), nil
+			}
+			elems := make(map[string]attr.Value, valFrom.Len())
+			iter := valFrom.MapRange()
+			for iter.Next() {
+				elem, err := v.flattenValue(ctx, iter.Value(), tElem)
+				if err != nil {
+					return nil, err
 				}
-				return nil
+				elems[iter.Key().String()] = elem
 			}
+			return types.MapValueMust(tElem, elems), nil
+		}
+	}
+
+	return nil, fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+}
+
+// flattenElements returns the framework values of type `tElem` corresponding to the elements of the slice `valFrom`.
+func (v flattenVisitor) flattenElements(ctx context.Context, valFrom reflect.Value, tElem attr.Type) ([]attr.Value, error) {
+	elems := make([]attr.Value, valFrom.Len())
+
+	for i := 0; i < valFrom.Len(); i++ {
+		elem, err := v.flattenValue(ctx, valFrom.Index(i), tElem)
+		if err != nil {
+			return nil, err
 		}
+		elems[i] = elem
+	}
+
+	return elems, nil
+}
+
+// flattenObject returns the framework Object value corresponding to the struct value `valFrom`.
+// Attributes with no corresponding struct field are set to null.
+func (v flattenVisitor) flattenObject(ctx context.Context, valFrom reflect.Value, tTo basetypes.ObjectType) (attr.Value, error) {
+	attrs := make(map[string]attr.Value, len(tTo.AttrTypes))
+
+	for name, tAttr := range tTo.AttrTypes {
+		var val attr.Value
+		if fieldVal := findFieldFuzzy(name, valFrom); fieldVal.IsValid() {
+			var err error
+			val, err = v.flattenValue(ctx, fieldVal, tAttr)
+			if err != nil {
+				return nil, fmt.Errorf("attribute (%s): %w", name, err)
+			}
+		} else {
+			var err error
+			val, err = nullValue(ctx, tAttr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		attrs[name] = val
+	}
+
+	obj, diags := types.ObjectValue(tTo.AttrTypes, attrs)
+	if diags.HasError() {
+		return nil, fmt.Errorf("creating Object value: %v", diags)
+	}
+
+	return obj, nil
+}
+
+// flattenNested copies an API struct, pointer to struct or slice of structs into
+// a nested Go struct, pointer to struct or slice of structs whose fields are framework values.
+func (v flattenVisitor) flattenNested(ctx context.Context, valFrom, valTo reflect.Value) error {
+	kFrom, kTo := valFrom.Kind(), valTo.Kind()
+
+	switch kFrom {
+	case reflect.Ptr:
+		if valFrom.IsNil() {
+			valTo.Set(reflect.Zero(valTo.Type()))
+			return nil
+		}
+		return v.flattenNested(ctx, valFrom.Elem(), valTo)
 
 	case reflect.Slice:
-		vFrom := valFrom.Interface()
-		switch tSliceElem := valFrom.Type().Elem(); tSliceElem.Kind() {
-		case reflect.String:
-			switch {
-			case tTo.TerraformType(ctx).Is(tftypes.List{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueList(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-				}
+		switch kTo {
+		case reflect.Slice:
+			if valFrom.IsNil() {
+				valTo.Set(reflect.Zero(valTo.Type()))
 				return nil
-
-			case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueSet(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
+			}
+			n := valFrom.Len()
+			s := reflect.MakeSlice(valTo.Type(), n, n)
+			for i := 0; i < n; i++ {
+				if err := v.flattenNested(ctx, valFrom.Index(i), s.Index(i)); err != nil {
+					return err
 				}
+			}
+			valTo.Set(s)
+			return nil
+
+		case reflect.Ptr, reflect.Struct:
+			if valFrom.Len() == 0 {
+				valTo.Set(reflect.Zero(valTo.Type()))
 				return nil
 			}
+			return v.flattenNested(ctx, valFrom.Index(0), valTo)
+		}
 
+	case reflect.Struct:
+		switch kTo {
 		case reflect.Ptr:
-			switch tSliceElem.Elem().Kind() {
-			case reflect.String:
-				switch {
-				case tTo.TerraformType(ctx).Is(tftypes.List{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringList(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-					}
-					return nil
-
-				case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringSet(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-					}
-					return nil
-				}
+			ptr := reflect.New(valTo.Type().Elem())
+			if err := v.flattenNested(ctx, valFrom, ptr.Elem()); err != nil {
+				return err
 			}
+			valTo.Set(ptr)
+			return nil
+
+		case reflect.Slice:
+			s := reflect.MakeSlice(valTo.Type(), 1, 1)
+			if err := v.flattenNested(ctx, valFrom, s.Index(0)); err != nil {
+				return err
+			}
+			valTo.Set(s)
+			return nil
+
+		case reflect.Struct:
+			return walkStructValues(ctx, valFrom, valTo, v)
+		}
+	}
+
+	return fmt.Errorf("incompatible (%s): %s", valFrom.Type(), valTo.Type())
+}
+
+// flattenString returns the framework value of type `tTo` corresponding to the string `s`.
+// Custom string types (for example, ARNs and timestamps) are supported.
+func flattenString(ctx context.Context, s string, tTo attr.Type, kFrom reflect.Kind) (attr.Value, error) {
+	switch {
+	case tTo.Equal(types.StringType):
+		return types.StringValue(s), nil
+	}
+
+	if tTo, ok := tTo.(basetypes.StringTypable); ok {
+		val, diags := tTo.ValueFromString(ctx, types.StringValue(s))
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting from string: %v", diags)
 		}
+		return val, nil
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+	return nil, fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+}
+
+// elementType returns the framework collection element type.
+// If the element type is unset (a zero-valued collection) and the source elements
+// are strings then the String type is assumed.
+func elementType(tElem attr.Type, typFrom reflect.Type) attr.Type {
+	if tElem != nil {
+		return tElem
+	}
+
+	for typFrom.Kind() == reflect.Ptr {
+		typFrom = typFrom.Elem()
+	}
+	if typFrom.Kind() == reflect.String {
+		return types.StringType
+	}
+
+	return nil
+}
+
+// nullValue returns the null value of the framework type `typ`.
+func nullValue(ctx context.Context, typ attr.Type) (attr.Value, error) {
+	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
 }
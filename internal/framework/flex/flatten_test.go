@@ -3,11 +3,13 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestFlatten struct{}
@@ -92,11 +94,67 @@ type UTestFlatten struct {
 	Names types.List
 }
 
+type VTestFlatten struct {
+	Name TestEnum
+}
+
+type WTestFlatten struct {
+	Names []TestEnum
+}
+
+type XTestFlatten struct {
+	Labels map[string]string
+}
+
+type YTestFlatten struct {
+	Labels types.Map
+}
+
+type ZTestFlatten struct {
+	CreationTime *time.Time
+}
+
+type AATestFlatten struct {
+	CreationTime fwtypes.TimestampValue
+}
+
+type ABTestFlatten struct {
+	Configs []ATestFlattenNested
+}
+
+type ACTestFlatten struct {
+	Config types.List
+}
+
+type ADTestFlatten struct {
+	Config []BTestFlattenNested
+}
+
+type AETestFlatten struct {
+	Config *ATestFlattenNested
+}
+
+type AFTestFlatten struct {
+	Config *BTestFlattenNested
+}
+
+type ATestFlattenNested struct {
+	MaxItems *int32
+	Name     string
+}
+
+type BTestFlattenNested struct {
+	MaxItems types.Int64  `tfsdk:"max_items"`
+	Name     types.String `tfsdk:"name"`
+}
+
 func TestGenericFlatten(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testTime := time.Date(2023, time.June, 7, 15, 11, 34, 0, time.UTC)
+	testObjectType := types.ObjectType{AttrTypes: AttributeTypesMust[BTestFlattenNested](ctx)}
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -282,6 +340,71 @@ func TestGenericFlatten(t *testing.T) {
 			Target:     &UTestFlatten{},
 			WantTarget: &UTestFlatten{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
 		},
+		{
+			TestName:   "single enum Source and single string Target",
+			Source:     &VTestFlatten{Name: TestEnum("a")},
+			Target:     &DTestFlatten{},
+			WantTarget: &DTestFlatten{Name: types.StringValue("a")},
+		},
+		{
+			TestName:   "single enum slice Source and single list Target",
+			Source:     &WTestFlatten{Names: []TestEnum{"a"}},
+			Target:     &UTestFlatten{},
+			WantTarget: &UTestFlatten{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
+		},
+		{
+			TestName:   "single string map Source and single map Target",
+			Source:     &XTestFlatten{Labels: map[string]string{"k": "v"}},
+			Target:     &YTestFlatten{},
+			WantTarget: &YTestFlatten{Labels: types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")})},
+		},
+		{
+			TestName:   "single nil string map Source and single map Target",
+			Source:     &XTestFlatten{},
+			Target:     &YTestFlatten{},
+			WantTarget: &YTestFlatten{Labels: types.MapNull(types.StringType)},
+		},
+		{
+			TestName:   "single *time Source and single timestamp Target",
+			Source:     &ZTestFlatten{CreationTime: aws.Time(testTime)},
+			Target:     &AATestFlatten{},
+			WantTarget: &AATestFlatten{CreationTime: fwtypes.NewTimestampValue(testTime)},
+		},
+		{
+			TestName:   "single nil *time Source and single timestamp Target",
+			Source:     &ZTestFlatten{},
+			Target:     &AATestFlatten{},
+			WantTarget: &AATestFlatten{CreationTime: fwtypes.NewTimestampNull()},
+		},
+		{
+			TestName: "nested struct slice Source and object list Target",
+			Source:   &ABTestFlatten{Configs: []ATestFlattenNested{{MaxItems: aws.Int32(5), Name: "a"}}},
+			Target:   &ACTestFlatten{Config: types.ListNull(testObjectType)},
+			WantTarget: &ACTestFlatten{Config: types.ListValueMust(testObjectType, []attr.Value{
+				types.ObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+					"max_items": types.Int64Value(5),
+					"name":      types.StringValue("a"),
+				}),
+			})},
+		},
+		{
+			TestName:   "nested struct slice Source and struct slice Target",
+			Source:     &ABTestFlatten{Configs: []ATestFlattenNested{{MaxItems: aws.Int32(5), Name: "a"}}},
+			Target:     &ADTestFlatten{},
+			WantTarget: &ADTestFlatten{Config: []BTestFlattenNested{{MaxItems: types.Int64Value(5), Name: types.StringValue("a")}}},
+		},
+		{
+			TestName:   "nested struct slice Source and struct pointer Target",
+			Source:     &ABTestFlatten{Configs: []ATestFlattenNested{{Name: "a"}}},
+			Target:     &AFTestFlatten{},
+			WantTarget: &AFTestFlatten{Config: &BTestFlattenNested{MaxItems: types.Int64Null(), Name: types.StringValue("a")}},
+		},
+		{
+			TestName:   "nil nested struct pointer Source and struct pointer Target",
+			Source:     &AETestFlatten{},
+			Target:     &AFTestFlatten{},
+			WantTarget: &AFTestFlatten{},
+		},
 	}
 
 	for _, testCase := range testCases {
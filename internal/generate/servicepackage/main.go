@@ -98,6 +98,17 @@ func main() {
 			}
 		}
 
+		for _, v := range s.FrameworkResources {
+			if len(v.Interceptors) > 0 {
+				s.HasInterceptors = true
+			}
+		}
+		for _, v := range s.SDKResources {
+			if len(v.Interceptors) > 0 {
+				s.HasInterceptors = true
+			}
+		}
+
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -125,6 +136,12 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	Interceptors            []string // Names of functions returning resource-specific interceptors
+	HasIAMActions           bool
+	IAMActionsCreate        []string
+	IAMActionsRead          []string
+	IAMActionsUpdate        []string
+	IAMActionsDelete        []string
 }
 
 type ServiceDatum struct {
@@ -139,6 +156,7 @@ type ServiceDatum struct {
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
+	HasInterceptors      bool
 }
 
 //go:embed file.tmpl
@@ -202,12 +220,27 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and IAM action annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		// Multiple actions are separated by semicolons, e.g. @IAMActions(create="sqs:CreateQueue;sqs:TagQueue", read="sqs:GetQueueAttributes").
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IAMActions" {
+			args := common.ParseArgs(m[3])
+
+			if d.HasIAMActions {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple IAMActions annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.HasIAMActions = true
+			d.IAMActionsCreate = splitAnnotationList(args.Keyword["create"])
+			d.IAMActionsRead = splitAnnotationList(args.Keyword["read"])
+			d.IAMActionsUpdate = splitAnnotationList(args.Keyword["update"])
+			d.IAMActionsDelete = splitAnnotationList(args.Keyword["delete"])
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -239,8 +272,23 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.Name = attr
 			}
 
+			// Multiple interceptors are separated by semicolons, e.g. interceptors=regionInterceptor;lockInterceptor.
+			if attr, ok := args.Keyword["interceptors"]; ok {
+				d.Interceptors = append(d.Interceptors, splitAnnotationList(attr)...)
+			}
+
 			switch annotationName := m[1]; annotationName {
 			case "FrameworkDataSource":
+				if len(d.Interceptors) > 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("interceptors not supported for data sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.HasIAMActions {
+					v.err = multierror.Append(v.err, fmt.Errorf("IAMActions not supported for data sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -253,6 +301,16 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
 			case "SDKDataSource":
+				if len(d.Interceptors) > 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("interceptors not supported for data sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.HasIAMActions {
+					v.err = multierror.Append(v.err, fmt.Errorf("IAMActions not supported for data sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -278,7 +336,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IAMActions", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
@@ -289,6 +347,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = ""
 }
 
+// splitAnnotationList splits a semicolon-separated annotation argument value into its non-empty elements.
+func splitAnnotationList(s string) []string {
+	var elems []string
+
+	for _, v := range strings.Split(s, ";") {
+		if v := strings.TrimSpace(v); v != "" {
+			elems = append(elems, v)
+		}
+	}
+
+	return elems
+}
+
 // Visit is called for each node visited by ast.Walk.
 func (v *visitor) Visit(node ast.Node) ast.Visitor {
 	// Look at functions (not methods) with comments.
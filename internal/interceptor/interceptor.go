@@ -0,0 +1,148 @@
+// Package interceptor defines functionality invoked during the CRUD request lifecycle of resources.
+//
+// Interceptors allow cross-cutting concerns (e.g. transparent tagging, region validation or mutex locking)
+// to be implemented once and applied to many resources.
+// Service packages contribute resource-specific interceptors via the `interceptors` argument of
+// the `@SDKResource` and `@FrameworkResource` annotations.
+package interceptor
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// When represents the point in the CRUD request lifecycle that an interceptor is run.
+// Multiple values can be ORed together.
+type When uint16
+
+const (
+	Before  When = 1 << iota // Interceptor is invoked before call to method in schema
+	After                    // Interceptor is invoked after successful call to method in schema
+	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
+	Finally                  // Interceptor is invoked after After or OnError
+)
+
+// Why represents the CRUD operation(s) that an interceptor is run.
+// Multiple values can be ORed together.
+type Why uint16
+
+const (
+	Create      Why = 1 << iota // Interceptor is invoked for a Create call
+	Read                        // Interceptor is invoked for a Read call
+	Update                      // Interceptor is invoked for an Update call
+	Delete                      // Interceptor is invoked for a Delete call
+	ModifyPlan                  // Interceptor is invoked for a ModifyPlan call (Plugin Framework resources only)
+	ImportState                 // Interceptor is invoked for an ImportState call (Plugin Framework resources only)
+
+	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all CRUD calls
+)
+
+// ResourceData is an interface that implements functions from schema.ResourceData
+type ResourceData interface {
+	Get(key string) any
+	GetChange(key string) (any, any)
+	GetRawConfig() cty.Value
+	GetRawPlan() cty.Value
+	GetRawState() cty.Value
+	HasChange(key string) bool
+	Id() string
+	Set(string, any) error
+}
+
+// An SDKResource interceptor is functionality invoked during a Plugin SDK v2 resource's CRUD request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+// In other cases all interceptors in the chain are run.
+type SDKResource interface {
+	Run(context.Context, ResourceData, any, When, Why, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+type SDKResourceFunc func(context.Context, ResourceData, any, When, Why, diag.Diagnostics) (context.Context, diag.Diagnostics)
+
+func (f SDKResourceFunc) Run(ctx context.Context, d ResourceData, meta any, when When, why Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return f(ctx, d, meta, when, why, diags)
+}
+
+// SDKResourceItem represents a single interceptor invocation.
+type SDKResourceItem struct {
+	When        When
+	Why         Why
+	Interceptor SDKResource
+}
+
+type SDKResourceItems []SDKResourceItem
+
+// Why returns a slice of interceptors that run for the specified CRUD operation.
+func (s SDKResourceItems) Why(why Why) SDKResourceItems {
+	return slices.Filter(s, func(e SDKResourceItem) bool {
+		return e.Why&why != 0
+	})
+}
+
+// A FrameworkResource interceptor is functionality invoked during a Plugin Framework resource's request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the resource's method.
+// In other cases all interceptors in the chain are run.
+// Embed FrameworkResourceNoOp to implement only the methods required.
+type FrameworkResource interface {
+	// Create is invoked for a Create call.
+	Create(context.Context, resource.CreateRequest, *resource.CreateResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+	// Read is invoked for a Read call.
+	Read(context.Context, resource.ReadRequest, *resource.ReadResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+	// Update is invoked for an Update call.
+	Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+	// Delete is invoked for a Delete call.
+	Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+	// ModifyPlan is invoked for a ModifyPlan call.
+	ModifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+	// ImportState is invoked for an ImportState call.
+	ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse, any, When, fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics)
+}
+
+// FrameworkResourceItem represents a single interceptor invocation.
+type FrameworkResourceItem struct {
+	When        When
+	Why         Why
+	Interceptor FrameworkResource
+}
+
+type FrameworkResourceItems []FrameworkResourceItem
+
+// Why returns a slice of interceptors that run for the specified operation.
+func (s FrameworkResourceItems) Why(why Why) FrameworkResourceItems {
+	return slices.Filter(s, func(e FrameworkResourceItem) bool {
+		return e.Why&why != 0
+	})
+}
+
+// FrameworkResourceNoOp implements FrameworkResource, doing nothing.
+type FrameworkResourceNoOp struct{}
+
+func (FrameworkResourceNoOp) Create(ctx context.Context, _ resource.CreateRequest, _ *resource.CreateResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
+
+func (FrameworkResourceNoOp) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
+
+func (FrameworkResourceNoOp) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
+
+func (FrameworkResourceNoOp) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
+
+func (FrameworkResourceNoOp) ModifyPlan(ctx context.Context, _ resource.ModifyPlanRequest, _ *resource.ModifyPlanResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
+
+func (FrameworkResourceNoOp) ImportState(ctx context.Context, _ resource.ImportStateRequest, _ *resource.ImportStateResponse, _ any, _ When, diags fwdiag.Diagnostics) (context.Context, fwdiag.Diagnostics) {
+	return ctx, diags
+}
@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestInterceptorsWhy(t *testing.T) {
+	t.Parallel()
+
+	var interceptors SDKResourceItems
+
+	interceptors = append(interceptors, SDKResourceItem{
+		When: Before,
+		Why:  Create,
+		Interceptor: SDKResourceFunc(func(ctx context.Context, d ResourceData, meta any, when When, why Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, diags
+		}),
+	})
+	interceptors = append(interceptors, SDKResourceItem{
+		When: After,
+		Why:  Delete,
+		Interceptor: SDKResourceFunc(func(ctx context.Context, d ResourceData, meta any, when When, why Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, diags
+		}),
+	})
+	interceptors = append(interceptors, SDKResourceItem{
+		When: Before,
+		Why:  Create,
+		Interceptor: SDKResourceFunc(func(ctx context.Context, d ResourceData, meta any, when When, why Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, diags
+		}),
+	})
+
+	if got, want := len(interceptors.Why(Create)), 2; got != want {
+		t.Errorf("length of interceptors.Why(Create) = %v, want %v", got, want)
+	}
+	if got, want := len(interceptors.Why(Read)), 0; got != want {
+		t.Errorf("length of interceptors.Why(Read) = %v, want %v", got, want)
+	}
+	if got, want := len(interceptors.Why(Update)), 0; got != want {
+		t.Errorf("length of interceptors.Why(Update) = %v, want %v", got, want)
+	}
+	if got, want := len(interceptors.Why(Delete)), 1; got != want {
+		t.Errorf("length of interceptors.Why(Delete) = %v, want %v", got, want)
+	}
+}
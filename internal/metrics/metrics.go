@@ -0,0 +1,275 @@
+// Package metrics implements provider-wide AWS API call metrics.
+//
+// AWS SDK for Go v1 request handlers and AWS SDK for Go v2 middleware record the number of calls, retries,
+// throttled attempts, errors and latency for each AWS API operation.
+// Metrics are recorded for the whole provider process and for any scope (e.g. a single resource operation)
+// added to the call's Context by NewContext.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// EnvVarFile is the environment variable specifying a file to which
+// the provider process's metrics are written in JSON format at shutdown.
+const EnvVarFile = "TF_AWS_METRICS_FILE"
+
+// Operation represents the metrics recorded for a single AWS API operation.
+type Operation struct {
+	Service    string        // AWS service ID, e.g. "EC2"
+	Operation  string        // AWS API operation name, e.g. "DescribeVpcs"
+	Calls      int64         // Number of calls
+	Errors     int64         // Number of calls that returned an error
+	Retries    int64         // Number of retried attempts
+	Throttles  int64         // Number of throttled attempts
+	Latency    time.Duration // Total latency, including retries
+	MaxLatency time.Duration // Latency of the slowest call
+}
+
+// call represents a single completed AWS API call.
+type call struct {
+	service   string
+	operation string
+	latency   time.Duration
+	retries   int
+	throttles int
+	err       error
+}
+
+// Recorder records AWS API call metrics.
+type Recorder struct {
+	lock       sync.Mutex
+	operations map[string]*Operation
+}
+
+// NewRecorder returns a new, empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		operations: make(map[string]*Operation),
+	}
+}
+
+// operation returns the metrics for the specified AWS API operation.
+// The caller must hold the lock.
+func (r *Recorder) operation(service, operation string) *Operation {
+	key := service + "." + operation
+	op, ok := r.operations[key]
+	if !ok {
+		op = &Operation{
+			Service:   service,
+			Operation: operation,
+		}
+		r.operations[key] = op
+	}
+
+	return op
+}
+
+func (r *Recorder) record(c call) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	op := r.operation(c.service, c.operation)
+	op.Calls++
+	if c.err != nil {
+		op.Errors++
+	}
+	op.Retries += int64(c.retries)
+	op.Throttles += int64(c.throttles)
+	op.Latency += c.latency
+	if c.latency > op.MaxLatency {
+		op.MaxLatency = c.latency
+	}
+}
+
+func (r *Recorder) recordThrottle(service, operation string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.operation(service, operation).Throttles++
+}
+
+// Operations returns the metrics recorded for each AWS API operation, slowest (by total latency) first.
+func (r *Recorder) Operations() []Operation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	operations := make([]Operation, 0, len(r.operations))
+	for _, v := range r.operations {
+		operations = append(operations, *v)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Latency != operations[j].Latency {
+			return operations[i].Latency > operations[j].Latency
+		}
+		if operations[i].Service != operations[j].Service {
+			return operations[i].Service < operations[j].Service
+		}
+		return operations[i].Operation < operations[j].Operation
+	})
+
+	return operations
+}
+
+// Total returns the metrics recorded for all AWS API operations.
+func (r *Recorder) Total() Operation {
+	var total Operation
+
+	for _, v := range r.Operations() {
+		total.Calls += v.Calls
+		total.Errors += v.Errors
+		total.Retries += v.Retries
+		total.Throttles += v.Throttles
+		total.Latency += v.Latency
+		if v.MaxLatency > total.MaxLatency {
+			total.MaxLatency = v.MaxLatency
+		}
+	}
+
+	return total
+}
+
+type operationJSON struct {
+	Service      string  `json:"service,omitempty"`
+	Operation    string  `json:"operation,omitempty"`
+	Calls        int64   `json:"calls"`
+	Errors       int64   `json:"errors"`
+	Retries      int64   `json:"retries"`
+	Throttles    int64   `json:"throttles"`
+	LatencyMS    float64 `json:"latency_ms"`
+	MaxLatencyMS float64 `json:"max_latency_ms"`
+}
+
+func newOperationJSON(v Operation) operationJSON {
+	return operationJSON{
+		Service:      v.Service,
+		Operation:    v.Operation,
+		Calls:        v.Calls,
+		Errors:       v.Errors,
+		Retries:      v.Retries,
+		Throttles:    v.Throttles,
+		LatencyMS:    milliseconds(v.Latency),
+		MaxLatencyMS: milliseconds(v.MaxLatency),
+	}
+}
+
+// MarshalJSON returns the JSON encoding of the recorded metrics.
+func (r *Recorder) MarshalJSON() ([]byte, error) {
+	operations := r.Operations()
+	v := struct {
+		Total      operationJSON   `json:"total"`
+		Operations []operationJSON `json:"operations"`
+	}{
+		Total:      newOperationJSON(r.Total()),
+		Operations: make([]operationJSON, len(operations)),
+	}
+
+	for i, op := range operations {
+		v.Operations[i] = newOperationJSON(op)
+	}
+
+	return json.Marshal(v)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+var global = NewRecorder()
+
+// Global returns the Recorder for the whole provider process.
+func Global() *Recorder {
+	return global
+}
+
+// WriteFile writes the provider process's metrics to the file specified by the TF_AWS_METRICS_FILE
+// environment variable. It does nothing if the environment variable is not set.
+func WriteFile() error {
+	path := os.Getenv(EnvVarFile)
+	if path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(global, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil { //nolint:gosec // Not a secret.
+		return fmt.Errorf("writing AWS API call metrics (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+type recorderKeyType int
+
+var recorderKey recorderKeyType
+
+// NewContext returns a Context enhanced with a new Recorder.
+// Metrics for AWS API calls made using the returned Context are recorded in the new Recorder
+// in addition to the provider process's Recorder.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, recorderKey, NewRecorder())
+}
+
+// FromContext returns the Recorder stored in a Context, or false if there isn't one.
+func FromContext(ctx context.Context) (*Recorder, bool) {
+	v, ok := ctx.Value(recorderKey).(*Recorder)
+	return v, ok
+}
+
+func recordCall(ctx context.Context, c call) {
+	global.record(c)
+
+	if r, ok := FromContext(ctx); ok {
+		r.record(c)
+	}
+}
+
+func recordThrottle(ctx context.Context, service, operation string) {
+	global.recordThrottle(service, operation)
+
+	if r, ok := FromContext(ctx); ok {
+		r.recordThrottle(service, operation)
+	}
+}
+
+// LogSummary logs a summary of the metrics recorded in the Context's Recorder.
+// It does nothing if no AWS API calls were recorded.
+func LogSummary(ctx context.Context) {
+	r, ok := FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	operations := r.Operations()
+	if len(operations) == 0 {
+		return
+	}
+
+	summary := make([]string, len(operations))
+	for i, v := range operations {
+		summary[i] = fmt.Sprintf("%s.%s: calls=%d errors=%d retries=%d throttles=%d latency=%s max_latency=%s",
+			v.Service, v.Operation, v.Calls, v.Errors, v.Retries, v.Throttles, v.Latency, v.MaxLatency)
+	}
+
+	total := r.Total()
+	tflog.Info(ctx, "AWS API call metrics", map[string]any{
+		"tf_aws.metrics.calls":      total.Calls,
+		"tf_aws.metrics.errors":     total.Errors,
+		"tf_aws.metrics.retries":    total.Retries,
+		"tf_aws.metrics.throttles":  total.Throttles,
+		"tf_aws.metrics.latency":    total.Latency.String(),
+		"tf_aws.metrics.operations": summary,
+	})
+}
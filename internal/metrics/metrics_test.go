@@ -0,0 +1,309 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ssm_sdkv1 "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// newStubServer returns a local HTTP server that throttles the first n requests and then succeeds.
+func newStubServer(t *testing.T, n int32) *httptest.Server {
+	t.Helper()
+
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		if atomic.AddInt32(&count, 1) <= n {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ThrottlingException","message":"Rate exceeded"}`)) //nolint:errcheck // Test stub.
+			return
+		}
+
+		w.Write([]byte(`{"Parameter":{"Name":"test","Value":"value"}}`)) //nolint:errcheck // Test stub.
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newSDKv1Conn(t *testing.T, endpoint string) *ssm_sdkv1.SSM {
+	t.Helper()
+
+	config := request.WithRetryer(&aws_sdkv1.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws_sdkv1.String(endpoint),
+		Region:      aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	}, client.DefaultRetryer{
+		NumMaxRetries:    3,
+		MinRetryDelay:    time.Millisecond,
+		MaxRetryDelay:    time.Millisecond,
+		MinThrottleDelay: time.Millisecond,
+		MaxThrottleDelay: time.Millisecond,
+	})
+	sess, err := session.NewSession(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AddSDKv1Handlers(&sess.Handlers)
+
+	return ssm_sdkv1.New(sess)
+}
+
+func newSDKv2Client(endpoint string) *ssm_sdkv2.Client {
+	return ssm_sdkv2.New(ssm_sdkv2.Options{
+		APIOptions: []func(*middleware.Stack) error{AddSDKv2Middleware},
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		}),
+		EndpointResolver: ssm_sdkv2.EndpointResolverFromURL(endpoint),
+		Region:           "us-west-2", //lintignore:AWSAT003
+		Retryer: retry_sdkv2.NewStandard(func(o *retry_sdkv2.StandardOptions) {
+			o.Backoff = retry_sdkv2.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+				return 0, nil
+			})
+		}),
+	})
+}
+
+func TestSDKv1(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		throttles       int32
+		expectError     bool
+		expectedRetries int64
+	}{
+		"no throttling": {
+			throttles: 0,
+		},
+		"throttled then success": {
+			throttles:       2,
+			expectedRetries: 2,
+		},
+		"retries exhausted": {
+			throttles:       10,
+			expectError:     true,
+			expectedRetries: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newSDKv1Conn(t, newStubServer(t, testCase.throttles).URL)
+			ctx := NewContext(context.Background())
+
+			_, err := conn.GetParameterWithContext(ctx, &ssm_sdkv1.GetParameterInput{Name: aws_sdkv1.String("test")})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error %t", err, want)
+			}
+
+			r, _ := FromContext(ctx)
+			operations := r.Operations()
+			if got, want := len(operations), 1; got != want {
+				t.Fatalf("len(Operations) = %d, want %d", got, want)
+			}
+
+			op := operations[0]
+			expectedErrors := int64(0)
+			if testCase.expectError {
+				expectedErrors = 1
+			}
+			expectedThrottles := int64(testCase.throttles)
+			if expectedThrottles > testCase.expectedRetries+1 {
+				expectedThrottles = testCase.expectedRetries + 1
+			}
+
+			if got, want := op.Service, "SSM"; got != want {
+				t.Errorf("Service = %q, want %q", got, want)
+			}
+			if got, want := op.Operation, "GetParameter"; got != want {
+				t.Errorf("Operation = %q, want %q", got, want)
+			}
+			if got, want := op.Calls, int64(1); got != want {
+				t.Errorf("Calls = %d, want %d", got, want)
+			}
+			if got, want := op.Errors, expectedErrors; got != want {
+				t.Errorf("Errors = %d, want %d", got, want)
+			}
+			if got, want := op.Retries, testCase.expectedRetries; got != want {
+				t.Errorf("Retries = %d, want %d", got, want)
+			}
+			if got, want := op.Throttles, expectedThrottles; got != want {
+				t.Errorf("Throttles = %d, want %d", got, want)
+			}
+			if op.Latency <= 0 || op.MaxLatency != op.Latency {
+				t.Errorf("Latency = %s, MaxLatency = %s", op.Latency, op.MaxLatency)
+			}
+		})
+	}
+}
+
+func TestSDKv2(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		throttles       int32
+		expectError     bool
+		expectedRetries int64
+	}{
+		"no throttling": {
+			throttles: 0,
+		},
+		"throttled then success": {
+			throttles:       2,
+			expectedRetries: 2,
+		},
+		"retries exhausted": {
+			throttles:       10,
+			expectError:     true,
+			expectedRetries: 2, // Default maximum of 3 attempts.
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newSDKv2Client(newStubServer(t, testCase.throttles).URL)
+			ctx := NewContext(context.Background())
+
+			_, err := client.GetParameter(ctx, &ssm_sdkv2.GetParameterInput{Name: aws_sdkv2.String("test")})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error %t", err, want)
+			}
+
+			r, _ := FromContext(ctx)
+			operations := r.Operations()
+			if got, want := len(operations), 1; got != want {
+				t.Fatalf("len(Operations) = %d, want %d", got, want)
+			}
+
+			op := operations[0]
+			expectedErrors := int64(0)
+			if testCase.expectError {
+				expectedErrors = 1
+			}
+			expectedThrottles := int64(testCase.throttles)
+			if expectedThrottles > testCase.expectedRetries+1 {
+				expectedThrottles = testCase.expectedRetries + 1
+			}
+
+			if got, want := op.Service, "SSM"; got != want {
+				t.Errorf("Service = %q, want %q", got, want)
+			}
+			if got, want := op.Operation, "GetParameter"; got != want {
+				t.Errorf("Operation = %q, want %q", got, want)
+			}
+			if got, want := op.Calls, int64(1); got != want {
+				t.Errorf("Calls = %d, want %d", got, want)
+			}
+			if got, want := op.Errors, expectedErrors; got != want {
+				t.Errorf("Errors = %d, want %d", got, want)
+			}
+			if got, want := op.Retries, testCase.expectedRetries; got != want {
+				t.Errorf("Retries = %d, want %d", got, want)
+			}
+			if got, want := op.Throttles, expectedThrottles; got != want {
+				t.Errorf("Throttles = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRecorderScope(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	r.record(call{service: "EC2", operation: "DescribeVpcs", latency: 2 * time.Millisecond})
+	r.record(call{service: "EC2", operation: "DescribeVpcs", latency: 3 * time.Millisecond, retries: 1, throttles: 1})
+	r.record(call{service: "S3", operation: "GetObject", latency: 10 * time.Millisecond, err: errors.New("test")})
+
+	operations := r.Operations()
+	if got, want := len(operations), 2; got != want {
+		t.Fatalf("len(Operations) = %d, want %d", got, want)
+	}
+	// Slowest first.
+	if got, want := operations[0].Operation, "GetObject"; got != want {
+		t.Errorf("Operations[0].Operation = %q, want %q", got, want)
+	}
+	if got, want := operations[1].MaxLatency, 3*time.Millisecond; got != want {
+		t.Errorf("Operations[1].MaxLatency = %s, want %s", got, want)
+	}
+
+	total := r.Total()
+	if got, want := total, (Operation{Calls: 3, Errors: 1, Retries: 1, Throttles: 1, Latency: 15 * time.Millisecond, MaxLatency: 10 * time.Millisecond}); got != want {
+		t.Errorf("Total = %+v, want %+v", got, want)
+	}
+
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("unexpected Recorder in Context")
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	// Not parallel as the environment is modified.
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	t.Setenv(EnvVarFile, path)
+
+	conn := newSDKv1Conn(t, newStubServer(t, 0).URL)
+	if _, err := conn.GetParameter(&ssm_sdkv1.GetParameterInput{Name: aws_sdkv1.String("test")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		Total struct {
+			Calls int64 `json:"calls"`
+		} `json:"total"`
+		Operations []struct {
+			Service   string `json:"service"`
+			Operation string `json:"operation"`
+		} `json:"operations"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.Total.Calls < 1 {
+		t.Errorf("total.calls = %d, want at least 1", v.Total.Calls)
+	}
+	if len(v.Operations) < 1 {
+		t.Errorf("no operations written")
+	}
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	middleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// AddSDKv1Handlers adds request handlers that record metrics to an AWS SDK for Go v1 session's handlers.
+func AddSDKv1Handlers(handlers *request_sdkv1.Handlers) {
+	// Run after each unsuccessful attempt.
+	handlers.Retry.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.metrics.Throttles",
+		Fn: func(r *request_sdkv1.Request) {
+			if request_sdkv1.IsErrorThrottle(r.Error) {
+				recordThrottle(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name)
+			}
+		},
+	})
+	// Run once, after the final attempt.
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf_aws.metrics.Calls",
+		Fn: func(r *request_sdkv1.Request) {
+			recordCall(r.Context(), call{
+				service:   r.ClientInfo.ServiceID,
+				operation: r.Operation.Name,
+				latency:   time.Since(r.Time),
+				retries:   r.RetryCount,
+				err:       r.Error,
+			})
+		},
+	})
+}
+
+// AddSDKv2Middleware adds middleware that records metrics to an AWS SDK for Go v2 API client's middleware stack.
+// It is suitable for use in aws.Config.APIOptions.
+func AddSDKv2Middleware(stack *middleware.Stack) error {
+	// Added after the service metadata middleware so that service ID and operation name are available.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tf_aws.metrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		c := call{
+			service:   middleware_sdkv2.GetServiceID(ctx),
+			operation: middleware_sdkv2.GetOperationName(ctx),
+			latency:   time.Since(start),
+			err:       err,
+		}
+
+		if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok {
+			throttles := retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles)
+
+			for _, v := range v.Results {
+				if v.Retried {
+					c.retries++
+				}
+				if v.Err != nil && throttles.IsErrorThrottle(v.Err) == aws_sdkv2.TrueTernary {
+					c.throttles++
+				}
+			}
+		}
+
+		recordCall(ctx, c)
+
+		return out, metadata, err
+	}), middleware.After)
+}
@@ -12,91 +12,55 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-type resourceCRUDRequest interface {
-	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
+type resourceRequest interface {
+	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest | resource.ModifyPlanRequest | resource.ImportStateRequest
 }
-type resourceCRUDResponse interface {
-	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
+type resourceResponse interface {
+	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse | resource.ModifyPlanResponse | resource.ImportStateResponse
 }
 
-// A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
-// If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
-// In other cases all interceptors in the chain are run.
-type resourceInterceptor interface {
-	// create is invoke for a Create call.
-	create(context.Context, resource.CreateRequest, *resource.CreateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
-	// read is invoke for a Read call.
-	read(context.Context, resource.ReadRequest, *resource.ReadResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
-	// update is invoke for an Update call.
-	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
-	// delete is invoke for a Delete call.
-	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
-}
-
-type resourceInterceptors []resourceInterceptor
-
-type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
-
-// create returns a slice of interceptors that run on resource Create.
-func (s resourceInterceptors) create() []resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-		return e.create
-	})
-}
+type resourceInterceptorFunc[Request resourceRequest, Response resourceResponse] func(context.Context, Request, *Response, any, interceptor.When, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
-// read returns a slice of interceptors that run on resource Read.
-func (s resourceInterceptors) read() []resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-		return e.read
-	})
-}
-
-// update returns a slice of interceptors that run on resource Update.
-func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-		return e.update
-	})
+// resourceInterceptorItem represents a single interceptor invocation for a specific resource operation.
+type resourceInterceptorItem[Request resourceRequest, Response resourceResponse] struct {
+	when        interceptor.When
+	interceptor resourceInterceptorFunc[Request, Response]
 }
 
-// delete returns a slice of interceptors that run on resource Delete.
-func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-		return e.delete
+// interceptorsFor returns a slice of interceptors that run for the specified resource operation.
+func interceptorsFor[Request resourceRequest, Response resourceResponse](s interceptor.FrameworkResourceItems, why interceptor.Why, f func(interceptor.FrameworkResource) resourceInterceptorFunc[Request, Response]) []resourceInterceptorItem[Request, Response] {
+	return slices.ApplyToAll(s.Why(why), func(e interceptor.FrameworkResourceItem) resourceInterceptorItem[Request, Response] {
+		return resourceInterceptorItem[Request, Response]{
+			when:        e.When,
+			interceptor: f(e.Interceptor),
+		}
 	})
 }
 
-// when represents the point in the CRUD request lifecycle that an interceptor is run.
-// Multiple values can be ORed together.
-type when uint16
-
-const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
-)
-
-// interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
+// interceptedHandler returns a handler that invokes the specified handler, running any interceptors.
+func interceptedHandler[Request resourceRequest, Response resourceResponse](interceptors []resourceInterceptorItem[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta any) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
 		forward := interceptors
 
-		when := Before
+		when := interceptor.Before
 		for _, v := range forward {
-			ctx, diags = v(ctx, request, response, meta, when, diags)
+			if v.when&when != 0 {
+				ctx, diags = v.interceptor(ctx, request, response, meta, when, diags)
 
-			// Short circuit if any Before interceptor errors.
-			if diags.HasError() {
-				return diags
+				// Short circuit if any Before interceptor errors.
+				if diags.HasError() {
+					return diags
+				}
 			}
 		}
 
@@ -105,17 +69,21 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 		diags = f(ctx, request, response)
 
 		if diags.HasError() {
-			when = OnError
+			when = interceptor.OnError
 		} else {
-			when = After
+			when = interceptor.After
 		}
 		for _, v := range reverse {
-			ctx, diags = v(ctx, request, response, meta, when, diags)
+			if v.when&when != 0 {
+				ctx, diags = v.interceptor(ctx, request, response, meta, when, diags)
+			}
 		}
 
-		when = Finally
+		when = interceptor.Finally
 		for _, v := range reverse {
-			ctx, diags = v(ctx, request, response, meta, when, diags)
+			if v.when&when != 0 {
+				ctx, diags = v.interceptor(ctx, request, response, meta, when, diags)
+			}
 		}
 
 		return diags
@@ -152,6 +120,8 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = metrics.NewContext(ctx)
+	defer metrics.LogSummary(ctx)
 	w.inner.Read(ctx, request, response)
 }
 
@@ -165,11 +135,11 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
+	interceptors     interceptor.FrameworkResourceItems
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors interceptor.FrameworkResourceItems) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
@@ -193,7 +163,9 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.Create, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+		return e.Create
+	}), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -203,7 +175,9 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.Read, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+		return e.Read
+	}), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -213,7 +187,9 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+	diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.Update, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+		return e.Update
+	}), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -223,7 +199,9 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+	diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.Delete, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+		return e.Delete
+	}), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -237,8 +215,15 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		f := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) diag.Diagnostics {
+			v.ImportState(ctx, request, response)
+			return response.Diagnostics
+		}
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.ImportState, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+			return e.ImportState
+		}), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
 
 		return
 	}
@@ -250,12 +235,17 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
-
-		return
+	f := func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
+		return response.Diagnostics
 	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(interceptorsFor(w.interceptors, interceptor.ModifyPlan, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+		return e.ModifyPlan
+	}), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -284,12 +274,45 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
+// metricsInterceptor scopes AWS API call metrics to a single CRUD handler invocation and logs a summary.
+type metricsInterceptor struct {
+	interceptor.FrameworkResourceNoOp
+}
+
+func (r metricsInterceptor) run(ctx context.Context, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case interceptor.Before:
+		ctx = metrics.NewContext(ctx)
+	case interceptor.Finally:
+		metrics.LogSummary(ctx)
+	}
+
+	return ctx, diags
+}
+
+func (r metricsInterceptor) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r metricsInterceptor) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r metricsInterceptor) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
+func (r metricsInterceptor) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, when, diags)
+}
+
 // tagsInterceptor implements transparent tagging.
 type tagsInterceptor struct {
+	interceptor.FrameworkResourceNoOp
 	tags *types.ServicePackageResourceTags
 }
 
-func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+func (r tagsInterceptor) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
 	}
@@ -305,7 +328,7 @@ func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequ
 	}
 
 	switch when {
-	case Before:
+	case interceptor.Before:
 		var planTags fwtypes.Map
 		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
 
@@ -319,7 +342,7 @@ func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequ
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
 		tagsInContext.TagsIn = types.Some(tags)
-	case After:
+	case interceptor.After:
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
 		// Computed tags_all include any provider configured default_tags.
@@ -334,7 +357,7 @@ func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequ
 	return ctx, diags
 }
 
-func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+func (r tagsInterceptor) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
 	}
@@ -344,7 +367,7 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 		return ctx, diags
 	}
 
-	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
+	sp, ok := meta.(*conns.AWSClient).ServicePackages[inContext.ServicePackageName]
 	if !ok {
 		return ctx, diags
 	}
@@ -365,7 +388,7 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	switch when {
-	case After:
+	case interceptor.After:
 		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
 		if response.State.Raw.IsNull() {
 			return ctx, diags
@@ -399,7 +422,7 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 					}
 
 					// ISO partitions may not support tagging, giving error.
-					if errs.IsUnsupportedOperationInPartitionError(meta.Partition, err) {
+					if errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
 						return ctx, diags
 					}
 
@@ -439,7 +462,7 @@ func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest,
 	return ctx, diags
 }
 
-func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+func (r tagsInterceptor) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
 	}
@@ -449,7 +472,7 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 		return ctx, diags
 	}
 
-	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
+	sp, ok := meta.(*conns.AWSClient).ServicePackages[inContext.ServicePackageName]
 	if !ok {
 		return ctx, diags
 	}
@@ -470,7 +493,7 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 	}
 
 	switch when {
-	case Before:
+	case interceptor.Before:
 		var planTags fwtypes.Map
 		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
 
@@ -526,7 +549,7 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 					}
 
 					// ISO partitions may not support tagging, giving error.
-					if errs.IsUnsupportedOperationInPartitionError(meta.Partition, err) {
+					if errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
 						return ctx, diags
 					}
 
@@ -543,7 +566,3 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 
 	return ctx, diags
 }
-
-func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	return ctx, diags
-}
@@ -0,0 +1,157 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+)
+
+type recordingInterceptor struct {
+	interceptor.FrameworkResourceNoOp
+	name  string
+	calls *[]string
+	fail  interceptor.When
+}
+
+func (r recordingInterceptor) record(when interceptor.When, diags diag.Diagnostics) diag.Diagnostics {
+	var s string
+	switch when {
+	case interceptor.Before:
+		s = "Before"
+	case interceptor.After:
+		s = "After"
+	case interceptor.OnError:
+		s = "OnError"
+	case interceptor.Finally:
+		s = "Finally"
+	}
+	*r.calls = append(*r.calls, r.name+"."+s)
+
+	if r.fail&when != 0 {
+		diags.AddError(r.name, s)
+	}
+
+	return diags
+}
+
+func (r recordingInterceptor) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta any, when interceptor.When, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, r.record(when, diags)
+}
+
+func TestInterceptedHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors func(*[]string) interceptor.FrameworkResourceItems
+		readError    bool
+		expected     []string
+		expectError  bool
+	}{
+		"no interceptors": {
+			interceptors: func(calls *[]string) interceptor.FrameworkResourceItems {
+				return nil
+			},
+			expected: []string{"Read"},
+		},
+		"ordering": {
+			interceptors: func(calls *[]string) interceptor.FrameworkResourceItems {
+				return interceptor.FrameworkResourceItems{
+					{
+						When:        interceptor.Before | interceptor.After | interceptor.OnError | interceptor.Finally,
+						Why:         interceptor.AllOps,
+						Interceptor: recordingInterceptor{name: "first", calls: calls},
+					},
+					{
+						When:        interceptor.Before | interceptor.After | interceptor.OnError | interceptor.Finally,
+						Why:         interceptor.AllOps,
+						Interceptor: recordingInterceptor{name: "second", calls: calls},
+					},
+				}
+			},
+			expected: []string{"first.Before", "second.Before", "Read", "second.After", "first.After", "second.Finally", "first.Finally"},
+		},
+		"filtered": {
+			interceptors: func(calls *[]string) interceptor.FrameworkResourceItems {
+				return interceptor.FrameworkResourceItems{
+					{
+						When:        interceptor.Finally,
+						Why:         interceptor.Read,
+						Interceptor: recordingInterceptor{name: "first", calls: calls},
+					},
+					{
+						When:        interceptor.Before | interceptor.After,
+						Why:         interceptor.Create | interceptor.ModifyPlan,
+						Interceptor: recordingInterceptor{name: "second", calls: calls},
+					},
+				}
+			},
+			expected: []string{"Read", "first.Finally"},
+		},
+		"read error": {
+			interceptors: func(calls *[]string) interceptor.FrameworkResourceItems {
+				return interceptor.FrameworkResourceItems{
+					{
+						When:        interceptor.Before | interceptor.After | interceptor.OnError | interceptor.Finally,
+						Why:         interceptor.Read,
+						Interceptor: recordingInterceptor{name: "first", calls: calls},
+					},
+				}
+			},
+			readError:   true,
+			expected:    []string{"first.Before", "Read", "first.OnError", "first.Finally"},
+			expectError: true,
+		},
+		"before error": {
+			interceptors: func(calls *[]string) interceptor.FrameworkResourceItems {
+				return interceptor.FrameworkResourceItems{
+					{
+						When:        interceptor.Before | interceptor.After | interceptor.OnError | interceptor.Finally,
+						Why:         interceptor.Read,
+						Interceptor: recordingInterceptor{name: "first", calls: calls, fail: interceptor.Before},
+					},
+					{
+						When:        interceptor.Before | interceptor.After | interceptor.OnError | interceptor.Finally,
+						Why:         interceptor.Read,
+						Interceptor: recordingInterceptor{name: "second", calls: calls},
+					},
+				}
+			},
+			expected:    []string{"first.Before"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			read := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+				calls = append(calls, "Read")
+				if testCase.readError {
+					response.Diagnostics.AddError("Read", "error")
+				}
+				return response.Diagnostics
+			}
+			interceptors := interceptorsFor(testCase.interceptors(&calls), interceptor.Read, func(e interceptor.FrameworkResource) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+				return e.Read
+			})
+
+			diags := interceptedHandler(interceptors, read, nil)(context.Background(), resource.ReadRequest{}, &resource.ReadResponse{})
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(calls, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
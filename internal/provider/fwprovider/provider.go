@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -60,6 +62,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoints_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a JSON or YAML file mapping service names to endpoint URLs. A `{region}` placeholder in a URL is replaced with the configured region.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -141,9 +147,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "Configuration blocks with settings for IAM Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -226,6 +230,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"concurrency_limits": schema.SetNestedBlock{
+				Description: "Configuration blocks with settings for limiting the number of concurrent executions of quota-bound resource operations.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent executions of the operation. `0` removes any default limit.",
+						},
+						"operation": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS API operation name, e.g. `CreateCluster`, whose executions are limited.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `eks`, of the operation.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -239,6 +262,40 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": schema.ListNestedBlock{
+							Description: "Configuration blocks with resource tags to default across the resources in a scope.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, excluded from the scope.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service packages, e.g. `ec2`, excluded from the scope.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, included in the scope. Defaults to all resource types.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service packages, e.g. `ec2`, included in the scope. Defaults to all service packages.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default across the resources in the scope.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -254,12 +311,102 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_suffixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"key_value": schema.ListNestedBlock{
+							Description: "Resource tags to ignore across all resources, matched by both key and value.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_regex": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the resource tag key.",
+									},
+									"value_regex": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the resource tag value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"rate_limits": schema.SetNestedBlock{
+				Description: "Configuration blocks with settings for client-side rate limiting of AWS API calls, by service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of AWS API calls that can be made at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate of AWS API calls, in requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `ec2`, whose AWS API calls are rate limited.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that resource tags must comply with across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are reported as errors or warnings.",
+						},
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that all resource tag keys must be in.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Rules for individual resource tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the resource tag is allowed to have.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether all resources must have the resource tag.",
+									},
+									"value_regex": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the resource tag's value must match.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -305,6 +452,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = create.NewRandomnessContext(ctx, meta.RandomnessSource)
 				}
 
 				return ctx
@@ -348,12 +496,23 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+					ctx = create.NewRandomnessContext(ctx, meta.RandomnessSource)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			// AWS API call metrics are scoped to the whole CRUD handler invocation.
+			// Any interceptors declared by the service package are next,
+			// e.g. so that a lock is held for the duration of transparent tagging.
+			interceptors := interceptor.FrameworkResourceItems{
+				{
+					When:        interceptor.Before | interceptor.Finally,
+					Why:         interceptor.AllOps,
+					Interceptor: metricsInterceptor{},
+				},
+			}
+			interceptors = append(interceptors, v.Interceptors...)
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -380,7 +539,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					continue
 				}
 
-				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
+				interceptors = append(interceptors, interceptor.FrameworkResourceItem{
+					When:        interceptor.Before | interceptor.After,
+					Why:         interceptor.Create | interceptor.Read | interceptor.Update,
+					Interceptor: tagsInterceptor{tags: v.Tags},
+				})
 			}
 
 			resources = append(resources, func() resource.Resource {
@@ -4,12 +4,13 @@ import (
 	"context"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -17,84 +18,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// schemaResourceData is an interface that implements functions from schema.ResourceData
-type schemaResourceData interface {
-	Get(key string) any
-	GetChange(key string) (any, any)
-	GetRawConfig() cty.Value
-	GetRawPlan() cty.Value
-	GetRawState() cty.Value
-	HasChange(key string) bool
-	Id() string
-	Set(string, any) error
-}
-
-// An interceptor is functionality invoked during the CRUD request lifecycle.
-// If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
-// In other cases all interceptors in the chain are run.
-type interceptor interface {
-	run(context.Context, schemaResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
-}
-
-type interceptorFunc func(context.Context, schemaResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
-
-func (f interceptorFunc) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	return f(ctx, d, meta, when, why, diags)
-}
-
-// interceptorItem represents a single interceptor invocation.
-type interceptorItem struct {
-	when        when
-	why         why
-	interceptor interceptor
-}
-
-// when represents the point in the CRUD request lifecycle that an interceptor is run.
-// Multiple values can be ORed together.
-type when uint16
-
-const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
-)
-
-// why represents the CRUD operation(s) that an interceptor is run.
-// Multiple values can be ORed together.
-type why uint16
-
-const (
-	Create why = 1 << iota // Interceptor is invoked for a Create call
-	Read                   // Interceptor is invoked for a Read call
-	Update                 // Interceptor is invoked for an Update call
-	Delete                 // Interceptor is invoked for a Delete call
-
-	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
-)
-
-type interceptorItems []interceptorItem
-
-// why returns a slice of interceptors that run for the specified CRUD operation.
-func (s interceptorItems) why(why why) interceptorItems {
-	return slices.Filter(s, func(e interceptorItem) bool {
-		return e.why&why != 0
-	})
-}
-
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
+func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptor.SDKResourceItems, f F, why interceptor.Why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
 		// Before interceptors are run first to last.
-		forward := interceptors.why(why)
+		forward := interceptors.Why(why)
 
-		when := Before
+		when := interceptor.Before
 		for _, v := range forward {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+			if v.When&when != 0 {
+				ctx, diags = v.Interceptor.Run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
 				if diags.HasError() {
@@ -108,20 +43,20 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		diags = f(ctx, d, meta)
 
 		if diags.HasError() {
-			when = OnError
+			when = interceptor.OnError
 		} else {
-			when = After
+			when = interceptor.After
 		}
 		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+			if v.When&when != 0 {
+				ctx, diags = v.Interceptor.Run(ctx, d, meta, when, why, diags)
 			}
 		}
 
-		when = Finally
+		when = interceptor.Finally
 		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+			if v.When&when != 0 {
+				ctx, diags = v.Interceptor.Run(ctx, d, meta, when, why, diags)
 			}
 		}
 
@@ -136,34 +71,34 @@ type contextFunc func(context.Context, any) context.Context
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	interceptors     interceptorItems
+	interceptors     interceptor.SDKResourceItems
 }
 
 func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(ds.bootstrapContext, ds.interceptors, f, Read)
+	return interceptedHandler(ds.bootstrapContext, ds.interceptors, f, interceptor.Read)
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	interceptors     interceptorItems
+	interceptors     interceptor.SDKResourceItems
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Create)
+	return interceptedHandler(r.bootstrapContext, r.interceptors, f, interceptor.Create)
 }
 
 func (r *wrappedResource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Read)
+	return interceptedHandler(r.bootstrapContext, r.interceptors, f, interceptor.Read)
 }
 
 func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Update)
+	return interceptedHandler(r.bootstrapContext, r.interceptors, f, interceptor.Update)
 }
 
 func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Delete)
+	return interceptedHandler(r.bootstrapContext, r.interceptors, f, interceptor.Delete)
 }
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
@@ -190,7 +125,21 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
-type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
+// metricsInterceptor scopes AWS API call metrics to a single CRUD handler invocation and logs a summary.
+type metricsInterceptor struct{}
+
+func (r metricsInterceptor) Run(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case interceptor.Before:
+		ctx = metrics.NewContext(ctx)
+	case interceptor.Finally:
+		metrics.LogSummary(ctx)
+	}
+
+	return ctx, diags
+}
+
+type tagsCRUDFunc func(context.Context, interceptor.ResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsInterceptor implements transparent tagging.
 type tagsInterceptor struct {
@@ -199,7 +148,7 @@ type tagsInterceptor struct {
 	readFunc   tagsCRUDFunc
 }
 
-func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+func (r tagsInterceptor) Run(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
 	}
@@ -230,9 +179,9 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 	}
 
 	switch when {
-	case Before:
+	case interceptor.Before:
 		switch why {
-		case Create, Update:
+		case interceptor.Create, interceptor.Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
 			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
 			// Remove system tags.
@@ -240,7 +189,18 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 
 			tagsInContext.TagsIn = types.Some(tags)
 
-			if why == Create {
+			// Non-compliant tags must never reach AWS.
+			if v, ok := meta.(*conns.AWSClient); ok {
+				if err := v.TagPolicyConfig.Evaluate(tags.IgnoreConfig(tagsInContext.IgnoreConfig)); err != nil {
+					if !v.TagPolicyConfig.Warn() {
+						return ctx, sdkdiag.AppendErrorf(diags, "%s %s: %s", serviceName, resourceName, err)
+					}
+
+					diags = sdkdiag.AppendWarningf(diags, "%s %s: %s", serviceName, resourceName, err)
+				}
+			}
+
+			if why == interceptor.Create {
 				break
 			}
 
@@ -286,18 +246,18 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 				}
 			}
 		}
-	case After:
+	case interceptor.After:
 		// Set tags and tags_all in state after CRU.
 		// C & U handlers are assumed to tail call the R handler.
 		switch why {
-		case Read:
+		case interceptor.Read:
 			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
 			if d.Id() == "" {
 				return ctx, diags
 			}
 
 			fallthrough
-		case Create, Update:
+		case interceptor.Create, interceptor.Update:
 			// If the R handler didn't set tags, try and read them from the service API.
 			if tagsInContext.TagsOut.IsNone() {
 				if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
@@ -356,9 +316,9 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
 			}
 		}
-	case Finally:
+	case interceptor.Finally:
 		switch why {
-		case Update:
+		case interceptor.Update:
 			if r.tags.IdentifierAttribute != "" && !d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
 				ctx, diags = r.updateFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
 				ctx, diags = r.readFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
@@ -7,72 +7,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 )
 
-func TestInterceptorsWhy(t *testing.T) {
-	t.Parallel()
-
-	var interceptors interceptorItems
-
-	interceptors = append(interceptors, interceptorItem{
-		when: Before,
-		why:  Create,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-			return ctx, diags
-		}),
-	})
-	interceptors = append(interceptors, interceptorItem{
-		when: After,
-		why:  Delete,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-			return ctx, diags
-		}),
-	})
-	interceptors = append(interceptors, interceptorItem{
-		when: Before,
-		why:  Create,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-			return ctx, diags
-		}),
-	})
-
-	if got, want := len(interceptors.why(Create)), 2; got != want {
-		t.Errorf("length of interceptors.Why(Create) = %v, want %v", got, want)
-	}
-	if got, want := len(interceptors.why(Read)), 0; got != want {
-		t.Errorf("length of interceptors.Why(Read) = %v, want %v", got, want)
-	}
-	if got, want := len(interceptors.why(Update)), 0; got != want {
-		t.Errorf("length of interceptors.Why(Update) = %v, want %v", got, want)
-	}
-	if got, want := len(interceptors.why(Delete)), 1; got != want {
-		t.Errorf("length of interceptors.Why(Delete) = %v, want %v", got, want)
-	}
-}
-
 func TestInterceptedHandler(t *testing.T) {
 	t.Parallel()
 
-	var interceptors interceptorItems
+	var interceptors interceptor.SDKResourceItems
 
-	interceptors = append(interceptors, interceptorItem{
-		when: Before,
-		why:  Create,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	interceptors = append(interceptors, interceptor.SDKResourceItem{
+		When: interceptor.Before,
+		Why:  interceptor.Create,
+		Interceptor: interceptor.SDKResourceFunc(func(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 			return ctx, diags
 		}),
 	})
-	interceptors = append(interceptors, interceptorItem{
-		when: After,
-		why:  Delete,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	interceptors = append(interceptors, interceptor.SDKResourceItem{
+		When: interceptor.After,
+		Why:  interceptor.Delete,
+		Interceptor: interceptor.SDKResourceFunc(func(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 			return ctx, diags
 		}),
 	})
-	interceptors = append(interceptors, interceptorItem{
-		when: Before,
-		why:  Create,
-		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	interceptors = append(interceptors, interceptor.SDKResourceItem{
+		When: interceptor.Before,
+		Why:  interceptor.Create,
+		Interceptor: interceptor.SDKResourceFunc(func(ctx context.Context, d interceptor.ResourceData, meta any, when interceptor.When, why interceptor.Why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 			return ctx, diags
 		}),
 	})
@@ -85,7 +45,7 @@ func TestInterceptedHandler(t *testing.T) {
 		return ctx
 	}
 
-	diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), nil, 42)
+	diags := interceptedHandler(bootstrapContext, interceptors, read, interceptor.Read)(context.Background(), nil, 42)
 	if got, want := len(diags), 1; got != want {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -17,11 +18,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -46,6 +52,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency_limits":            concurrencyLimitsSchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -60,6 +67,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with resource tags to default across the resources in a scope.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_instance`, excluded from the scope.",
+									},
+									"exclude_services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Service packages, e.g. `ec2`, excluded from the scope.",
+									},
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_instance`, included in the scope. Defaults to all resource types.",
+									},
+									"services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Service packages, e.g. `ec2`, included in the scope. Defaults to all service packages.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across the resources in the scope.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -82,6 +128,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoints_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a JSON or YAML file mapping service names to endpoint URLs. " +
+					"A `{region}` placeholder in a URL is replaced with the configured region.",
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -116,6 +168,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_suffixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
+						"key_value": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tags to ignore across all resources, matched by both key and value.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_regex": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the resource tag key.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the resource tag value.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -138,6 +228,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": rateLimitsSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -207,6 +298,60 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that resource tags must comply with across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      tftags.PolicyEnforcementError,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyEnforcement_Values(), false),
+							Description:  "Whether tag policy violations are reported as errors or warnings.",
+						},
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyKeyCase_Values(), false),
+							Description:  "Case that all resource tag keys must be in.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules for individual resource tag keys.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values that the resource tag is allowed to have.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether all resources must have the resource tag.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the resource tag's value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -265,11 +410,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = create.NewRandomnessContext(ctx, v.RandomnessSource)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptor.SDKResourceItems{
+				{
+					When:        interceptor.Before | interceptor.Finally,
+					Why:         interceptor.Read,
+					Interceptor: metricsInterceptor{},
+				},
+			}
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -315,12 +467,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
+					ctx = create.NewRandomnessContext(ctx, v.RandomnessSource)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			// AWS API call metrics are scoped to the whole CRUD handler invocation.
+			// Any interceptors declared by the service package are next,
+			// e.g. so that a lock is held for the duration of transparent tagging.
+			interceptors := interceptor.SDKResourceItems{
+				{
+					When:        interceptor.Before | interceptor.Finally,
+					Why:         interceptor.AllOps,
+					Interceptor: metricsInterceptor{},
+				},
+			}
+			interceptors = append(interceptors, v.Interceptors...)
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -346,10 +509,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
-				interceptors = append(interceptors, interceptorItem{
-					when: Before | After | Finally,
-					why:  Create | Read | Update,
-					interceptor: tagsInterceptor{
+				interceptors = append(interceptors, interceptor.SDKResourceItem{
+					When: interceptor.Before | interceptor.After | interceptor.Finally,
+					Why:  interceptor.Create | interceptor.Read | interceptor.Update,
+					Interceptor: tagsInterceptor{
 						tags:       v.Tags,
 						updateFunc: tagsUpdateFunc,
 						readFunc:   tagsReadFunc,
@@ -455,14 +618,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		config.AssumeRole = expandAssumeRoles(ctx, v.([]interface{}))
+		for i, assumeRole := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.hop":             i + 1,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -473,32 +639,71 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && v.(*schema.Set).Len() > 0 {
+		concurrencyLimits, err := expandConcurrencyLimits(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
-		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
+	// Endpoint environment variables apply even when there is no endpoints configuration block.
+	endpoints, err := expandEndpoints(ctx, d.Get("endpoints").(*schema.Set).List())
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("endpoints_file"); ok {
+		fileEndpoints, err := readEndpointsFile(ctx, v.(string), config.Region)
 
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		config.Endpoints = endpoints
+		for pkg, endpoint := range fileEndpoints {
+			if endpoints[pkg] == "" {
+				endpoints[pkg] = endpoint
+			}
+		}
 	}
 
+	config.Endpoints = endpoints
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, err := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.IgnoreTagsConfig = ignoreTagsConfig
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && v.(*schema.Set).Len() > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -515,12 +720,51 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
 	} else {
 		meta = new(conns.AWSClient)
 	}
+
+	if vcr.IsEnabled() {
+		httpClient, err := vcr.HTTPClient(ctx)
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// The HTTP client must be set before the provider is configured.
+		meta.SetHTTPClient(httpClient)
+
+		seed, err := vcr.Seed()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// Provider-generated names and tokens must be identical when replaying.
+		meta.RandomnessSource = create.NewRandomnessSource(seed)
+
+		if vcr.IsReplaying() {
+			// Replayed interactions have redacted credentials and no real credentials are required.
+			config.AccessKey = vcr.RedactedAccessKeyID
+			config.SecretKey = vcr.RedactedSecret
+			config.Token = ""
+			config.EC2MetadataServiceEnableState = imds.ClientDisabled
+		}
+	}
+
 	meta, diags := config.ConfigureProvider(ctx, meta)
 
 	if diags.HasError() {
@@ -532,9 +776,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings for IAM Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -679,6 +923,84 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func concurrencyLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with settings for limiting the number of concurrent executions of quota-bound resource operations.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"limit": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "The maximum number of concurrent executions of the operation. `0` removes any default limit.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"operation": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS API operation name, e.g. `CreateCluster`, whose executions are limited.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service package name, e.g. `eks`, of the operation.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with settings for client-side rate limiting of AWS API calls, by service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of AWS API calls that can be made at once. Defaults to `requests_per_second`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  "The sustained rate of AWS API calls, in requests per second.",
+					ValidateFunc: validRateLimitRequestsPerSecond,
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service package name, e.g. `ec2`, whose AWS API calls are rate limited.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+// expandAssumeRoles returns the chain of IAM Roles to assume, ignoring any blocks without a role ARN.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) []*awsbase.AssumeRole {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if assumeRole := expandAssumeRole(ctx, tfMap); assumeRole.RoleARN != "" {
+			assumeRoles = append(assumeRoles, assumeRole)
+		}
+	}
+
+	return assumeRoles
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -776,12 +1098,46 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["scope"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			scope := tftags.DefaultScope{}
+
+			if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+				scope.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["exclude_services"].(*schema.Set); ok {
+				scope.ExcludeServices = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				scope.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["services"].(*schema.Set); ok {
+				scope.Services = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				scope.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.Scopes = append(defaultConfig.Scopes, scope)
+		}
+	}
+
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -794,14 +1150,205 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			re, err := regexp.Compile(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("ignore_tags key_regexes: %w", err)
+			}
+
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, re)
+		}
+	}
+
+	if v, ok := tfMap["key_suffixes"].(*schema.Set); ok {
+		ignoreConfig.KeySuffixes = tftags.New(ctx, v.List())
+	}
+
+	if v, ok := tfMap["key_value"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key, err := regexp.Compile(tfMap["key_regex"].(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("ignore_tags key_value key_regex: %w", err)
+			}
+
+			value, err := regexp.Compile(tfMap["value_regex"].(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("ignore_tags key_value value_regex: %w", err)
+			}
+
+			ignoreConfig.KeyValues = append(ignoreConfig.KeyValues, tftags.IgnoreKeyValue{
+				Key:   key,
+				Value: value,
+			})
+		}
+	}
+
+	return ignoreConfig, nil
 }
 
-func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		policyConfig.Enforcement = v
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		policyConfig.KeyCase = v
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["key"].(string); ok {
+				rule.Key = v
+			}
+
+			if v, ok := tfMap["required"].(bool); ok {
+				rule.Required = v
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				re, err := regexp.Compile(v)
+
+				if err != nil {
+					return nil, fmt.Errorf("tag_policy rule (%s) value_regex: %w", rule.Key, err)
+				}
+
+				rule.ValuePattern = re
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+	}
+
+	return policyConfig, nil
+}
+
+// readEndpointsFile returns the service endpoints from a JSON or YAML file mapping service names,
+// as in the endpoints configuration block, to endpoint URLs.
+func readEndpointsFile(_ context.Context, filename, region string) (map[string]string, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading endpoints_file: %w", err)
+	}
+
+	// YAML is a superset of JSON.
+	var m map[string]string
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("parsing endpoints_file (%s): %w", filename, err)
+	}
+
+	endpoints := make(map[string]string, len(m))
+
+	for alias, endpoint := range m {
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("endpoints_file (%s): %w", filename, err)
+		}
+
+		endpoints[pkg] = strings.ReplaceAll(endpoint, "{region}", region)
+	}
+
+	return endpoints, nil
+}
+
+func expandConcurrencyLimits(_ context.Context, tfList []interface{}) (map[string]int, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	concurrencyLimits := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfsync.LimiterKey(tfMap["service"].(string), tfMap["operation"].(string))
+
+		if _, ok := concurrencyLimits[key]; ok {
+			return nil, fmt.Errorf("duplicate concurrency limit (%s)", key)
+		}
+
+		concurrencyLimits[key] = tfMap["limit"].(int)
+	}
+
+	return concurrencyLimits, nil
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.RateLimit, error) {
 	if len(tfList) == 0 {
 		return nil, nil
 	}
 
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("duplicate rate limit (%s)", service)
+		}
+
+		rateLimit := conns.RateLimit{
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+
+		if v, ok := tfMap["burst"].(int); ok && v > 0 {
+			rateLimit.Burst = v
+		}
+
+		rateLimits[service] = rateLimit
+	}
+
+	return rateLimits, nil
+}
+
+// expandEndpoints returns the service endpoints from the endpoints configuration block and environment variables.
+// For each service, in order of precedence, the endpoint is from:
+//   - The endpoints configuration block
+//   - The AWS_ENDPOINT_URL_<SERVICE> environment variable, e.g. AWS_ENDPOINT_URL_DYNAMODB
+//   - The service's environment variable in names/names_data.csv, e.g. TF_AWS_DYNAMODB_ENDPOINT
+//   - The service's deprecated environment variable in names/names_data.csv, e.g. AWS_DYNAMODB_ENDPOINT
+//
+// Any endpoints_file has lower precedence than all of these.
+func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	endpoints := make(map[string]string)
 
 	for _, tfMapRaw := range tfList {
@@ -831,6 +1378,11 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 			continue
 		}
 
+		if v := os.Getenv(names.EndpointURLEnvVar(pkg)); v != "" {
+			endpoints[pkg] = v
+			continue
+		}
+
 		envVar := names.EnvVar(pkg)
 		if envVar != "" {
 			if v := os.Getenv(envVar); v != "" {
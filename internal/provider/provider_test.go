@@ -2,10 +2,18 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -50,6 +58,199 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, err := expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"limit":     2,
+			"operation": "CreateCluster",
+			"service":   "eks",
+		},
+		map[string]interface{}{
+			"limit":     0,
+			"operation": "CreateDirectory",
+			"service":   "ds",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]int{
+		"eks/CreateCluster":  2,
+		"ds/CreateDirectory": 0,
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"limit":     1,
+			"operation": "CreateCluster",
+			"service":   "eks",
+		},
+		map[string]interface{}{
+			"limit":     2,
+			"operation": "CreateCluster",
+			"service":   "eks",
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for duplicate operation")
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results := expandAssumeRoles(ctx, []interface{}{
+		map[string]interface{}{
+			"role_arn":     "arn:aws:iam::111111111111:role/identity", //lintignore:AWSAT005
+			"session_name": "identity",
+		},
+		nil,
+		map[string]interface{}{
+			"role_arn": "",
+		},
+		map[string]interface{}{
+			"external_id": "external",
+			"role_arn":    "arn:aws:iam::222222222222:role/workload", //lintignore:AWSAT005
+		},
+	})
+
+	expected := []*awsbase.AssumeRole{
+		{
+			RoleARN:     "arn:aws:iam::111111111111:role/identity", //lintignore:AWSAT005
+			SessionName: "identity",
+		},
+		{
+			ExternalID: "external",
+			RoleARN:    "arn:aws:iam::222222222222:role/workload", //lintignore:AWSAT005
+		},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	result, err := expandTagPolicy(ctx, map[string]interface{}{
+		"enforcement": "warning",
+		"key_case":    "pascal",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"allowed_values": schema.NewSet(schema.HashString, []interface{}{"dev", "prod"}),
+				"key":            "Environment",
+				"required":       true,
+				"value_regex":    "",
+			},
+			map[string]interface{}{
+				"allowed_values": schema.NewSet(schema.HashString, nil),
+				"key":            "CostCenter",
+				"required":       false,
+				"value_regex":    "^[0-9]{4}$",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got, want := result.Enforcement, "warning"; got != want {
+		t.Errorf("Enforcement = %q, want %q", got, want)
+	}
+	if got, want := result.KeyCase, "pascal"; got != want {
+		t.Errorf("KeyCase = %q, want %q", got, want)
+	}
+
+	// Tags are checked against the expanded rules.
+	err = result.Evaluate(tftags.New(ctx, map[string]string{
+		"CostCenter":  "abc",
+		"Environment": "test",
+	}))
+
+	var policyErr *tftags.PolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("expected PolicyError, got %v", err)
+	}
+
+	expected := []string{
+		`tag "Environment" value "test" is not one of ["dev" "prod"]`,
+		`tag "CostCenter" value "abc" does not match "^[0-9]{4}$"`,
+	}
+	if diff := cmp.Diff(policyErr.Violations, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = expandTagPolicy(ctx, map[string]interface{}{
+		"rule": []interface{}{
+			map[string]interface{}{
+				"key":         "CostCenter",
+				"value_regex": "[",
+			},
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for invalid value_regex")
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, err := expandRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"burst":               0,
+			"requests_per_second": 2.5,
+			"service":             "ec2",
+		},
+		map[string]interface{}{
+			"burst":               10,
+			"requests_per_second": 5.0,
+			"service":             "route53",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.RateLimit{
+		"ec2":     {RequestsPerSecond: 2.5},
+		"route53": {RequestsPerSecond: 5, Burst: 10},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = expandRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"burst":               0,
+			"requests_per_second": 1.0,
+			"service":             "ec2",
+		},
+		map[string]interface{}{
+			"burst":               1,
+			"requests_per_second": 2.0,
+			"service":             "ec2",
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for duplicate service")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -151,6 +352,34 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 			expectedService:  names.STS,
 			expectedEndpoint: "https://sts-config.fake.test",
 		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-url.fake.test",
+				"TF_AWS_STS_ENDPOINT":  "https://sts.fake.test",
+				"AWS_STS_ENDPOINT":     "https://sts-deprecated.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-url.fake.test",
+		},
+		{
+			endpoints: map[string]string{
+				"sts": "https://sts-config.fake.test",
+			},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-url.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-config.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_SQS": "https://sqs-url.fake.test",
+			},
+			expectedService:  names.SQS,
+			expectedEndpoint: "https://sqs-url.fake.test",
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -184,6 +413,94 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestEndpointEnvVarWithoutEndpoints(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	os.Setenv("AWS_ENDPOINT_URL_DYNAMODB", "https://dynamodb.fake.test")
+
+	results, err := expandEndpoints(ctx, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if v, e := results[names.DynamoDB], "https://dynamodb.fake.test"; v != e {
+		t.Errorf("Expected endpoint[%s] to be %q, got %v", names.DynamoDB, e, results)
+	}
+}
+
+func TestReadEndpointsFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := []struct {
+		name          string
+		contents      string
+		expected      map[string]string
+		expectedError bool
+	}{
+		{
+			name:     "JSON",
+			contents: `{"sqs": "http://localhost:4566", "s3": "https://s3.{region}.fake.test"}`,
+			expected: map[string]string{
+				names.S3:  "https://s3.us-west-2.fake.test",
+				names.SQS: "http://localhost:4566",
+			},
+		},
+		{
+			name: "YAML",
+			contents: `
+sns: http://localhost:4566
+transcribeservice: https://transcribe.{region}.fake.test
+`,
+			expected: map[string]string{
+				names.SNS:        "http://localhost:4566",
+				names.Transcribe: "https://transcribe.us-west-2.fake.test",
+			},
+		},
+		{
+			name:          "unknown service",
+			contents:      `{"notaservice": "http://localhost:4566"}`,
+			expectedError: true,
+		},
+		{
+			name:          "invalid",
+			contents:      `[`,
+			expectedError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "endpoints")
+			if err := os.WriteFile(filename, []byte(testcase.contents), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			results, err := readEndpointsFile(ctx, filename, "us-west-2")
+
+			if testcase.expectedError {
+				if err == nil {
+					t.Fatal("Expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(results, testcase.expected) {
+				t.Errorf("Expected %v, got %v", testcase.expected, results)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
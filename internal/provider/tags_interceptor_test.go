@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -52,7 +53,7 @@ func (t *mockService) UpdateTags(context.Context, any, string, string, any) erro
 func TestTagsInterceptor(t *testing.T) {
 	t.Parallel()
 
-	var interceptors interceptorItems
+	var interceptors interceptor.SDKResourceItems
 
 	sp := &types.ServicePackageResourceTags{
 		IdentifierAttribute: "id",
@@ -64,12 +65,19 @@ func TestTagsInterceptor(t *testing.T) {
 		readFunc:   tagsReadFunc,
 	}
 
-	interceptors = append(interceptors, interceptorItem{
-		when:        Finally,
-		why:         Update,
-		interceptor: tags,
+	interceptors = append(interceptors, interceptor.SDKResourceItem{
+		When:        interceptor.Finally,
+		Why:         interceptor.Update,
+		Interceptor: tags,
 	})
 
+	ignoreTagsConfig, err := expandIgnoreTags(context.Background(), map[string]interface{}{
+		"tag2": "tag",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	conn := &conns.AWSClient{
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
@@ -77,9 +85,7 @@ func TestTagsInterceptor(t *testing.T) {
 		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
 			"tag": "",
 		}),
-		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
-			"tag2": "tag",
-		}),
+		IgnoreTagsConfig: ignoreTagsConfig,
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
@@ -96,7 +102,7 @@ func TestTagsInterceptor(t *testing.T) {
 
 	for _, v := range interceptors {
 		var diags diag.Diagnostics
-		_, diags = v.interceptor.run(ctx, d, conn, v.when, v.why, diags)
+		_, diags = v.Interceptor.Run(ctx, d, conn, v.When, v.Why, diags)
 		if got, want := len(diags), 1; got != want {
 			t.Errorf("length of diags = %v, want %v", got, want)
 		}
@@ -25,6 +25,15 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// validRateLimitRequestsPerSecond validates a rate limit is greater than 0 requests per second.
+func validRateLimitRequestsPerSecond(v interface{}, k string) (ws []string, errors []error) {
+	if v.(float64) <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than 0", k))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
@@ -68,3 +68,25 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRateLimitRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         float64
+		expectError bool
+	}{
+		{val: -1, expectError: true},
+		{val: 0, expectError: true},
+		{val: 0.5},
+		{val: 100},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validRateLimitRequestsPerSecond(tc.val, "test_property")
+
+		if got, want := len(errs) != 0, tc.expectError; got != want {
+			t.Errorf("test case %d: got errors %v, expected error %t", i, errs, want)
+		}
+	}
+}
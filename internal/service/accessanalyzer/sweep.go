@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -18,27 +18,27 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
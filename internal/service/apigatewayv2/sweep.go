@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
 		Name: "aws_applicationinsights_application",
 		F:    sweepApplications,
 	})
@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -44,12 +44,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -57,7 +57,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
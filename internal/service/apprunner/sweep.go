@@ -15,19 +15,19 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
 		Name:         "aws_apprunner_auto_scaling_configuration_version",
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
@@ -14,22 +14,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
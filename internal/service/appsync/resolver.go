@@ -224,8 +224,10 @@ func resourceResolverCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Resolver: %s", err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return conn.CreateResolverWithContext(ctx, input)
@@ -350,8 +352,10 @@ func resourceResolverUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppSync Resolver (%s): %s", d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return conn.UpdateResolverWithContext(ctx, input)
@@ -381,8 +385,10 @@ func resourceResolverDelete(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppSync Resolver (%s): %s", d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return conn.DeleteResolverWithContext(ctx, input)
@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
 		Name: "aws_appsync_graphql_api",
 		F:    sweepGraphQLAPIs,
 	})
 
-	resource.AddTestSweepers("aws_appsync_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_domain_name", &resource.Sweeper{
 		Name: "aws_appsync_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appsync_domain_name_api_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_domain_name_api_association", &resource.Sweeper{
 		Name: "aws_appsync_domain_name_api_association",
 		F:    sweepDomainNameAssociations,
 	})
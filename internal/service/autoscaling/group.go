@@ -852,7 +852,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	startTime := time.Now()
 
-	asgName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	asgName := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	createInput := &autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    sweepGroups,
 	})
 
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
 		Name:         "aws_launch_configuration",
 		F:            sweepLaunchConfigurations,
 		Dependencies: []string{"aws_autoscaling_group"},
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
 		Name: "aws_autoscalingplans_scaling_plan",
 		F:    sweepScalingPlans,
 	})
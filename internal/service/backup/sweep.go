@@ -15,32 +15,32 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
 		Name: "aws_backup_framework",
 		F:    sweepFramework,
 	})
 
-	resource.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
 		Name: "aws_backup_report_plan",
 		F:    sweepReportPlan,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -26,7 +26,7 @@ func init() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -34,12 +34,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
 
-	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
 		Name: "aws_batch_scheduling_policy",
 		F:    sweepSchedulingPolicies,
 		Dependencies: []string{
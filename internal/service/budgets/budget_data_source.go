@@ -268,7 +268,7 @@ const (
 func dataSourceBudgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).BudgetsConn(ctx)
 
-	budgetName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	budgetName := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	accountID := d.Get("account_id").(string)
 	if accountID == "" {
@@ -9,6 +9,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation_sdkv1 "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -57,6 +58,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceStackSetInstance,
 			TypeName: "aws_cloudformation_stack_set_instance",
+			Interceptors: interceptor.SDKResourceItems{
+				stackSetInstanceCreateConcurrencyLimitInterceptor(),
+				stackSetInstanceUpdateConcurrencyLimitInterceptor(),
+				stackSetInstanceDeleteConcurrencyLimitInterceptor(),
+			},
 		},
 		{
 			Factory:  ResourceType,
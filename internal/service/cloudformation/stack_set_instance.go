@@ -11,17 +11,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_cloudformation_stack_set_instance")
+// @SDKResource("aws_cloudformation_stack_set_instance", interceptors=stackSetInstanceCreateConcurrencyLimitInterceptor;stackSetInstanceUpdateConcurrencyLimitInterceptor;stackSetInstanceDeleteConcurrencyLimitInterceptor)
 func ResourceStackSetInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStackSetInstanceCreate,
@@ -154,6 +156,18 @@ func ResourceStackSetInstance() *schema.Resource {
 	}
 }
 
+func stackSetInstanceCreateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.CloudFormation, "CreateStackInstances", 0, interceptor.Create)
+}
+
+func stackSetInstanceUpdateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.CloudFormation, "UpdateStackInstances", 0, interceptor.Update)
+}
+
+func stackSetInstanceDeleteConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.CloudFormation, "DeleteStackInstances", 0, interceptor.Delete)
+}
+
 func resourceStackSetInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
@@ -200,7 +214,7 @@ func resourceStackSetInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] Creating CloudFormation StackSet Instance: %s", input)
 	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			input.OperationId = aws.String(id.UniqueId())
+			input.OperationId = aws.String(create.UniqueId(ctx))
 
 			output, err := conn.CreateStackInstancesWithContext(ctx, input)
 
@@ -326,7 +340,7 @@ func resourceStackSetInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		input := &cloudformation.UpdateStackInstancesInput{
 			Accounts:           aws.StringSlice([]string{accountID}),
-			OperationId:        aws.String(id.UniqueId()),
+			OperationId:        aws.String(create.UniqueId(ctx)),
 			ParameterOverrides: []*cloudformation.Parameter{},
 			Regions:            aws.StringSlice([]string{region}),
 			StackSetName:       aws.String(stackSetName),
@@ -378,7 +392,7 @@ func resourceStackSetInstanceDelete(ctx context.Context, d *schema.ResourceData,
 
 	input := &cloudformation.DeleteStackInstancesInput{
 		Accounts:     aws.StringSlice([]string{accountID}),
-		OperationId:  aws.String(id.UniqueId()),
+		OperationId:  aws.String(create.UniqueId(ctx)),
 		Regions:      aws.StringSlice([]string{region}),
 		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
 		StackSetName: aws.String(stackSetName),
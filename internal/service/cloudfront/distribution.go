@@ -834,7 +834,7 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	input := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
-			DistributionConfig: expandDistributionConfig(d),
+			DistributionConfig: expandDistributionConfig(ctx, d),
 			Tags:               &cloudfront.Tags{Items: []*cloudfront.Tag{}},
 		},
 	}
@@ -928,7 +928,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
-		DistributionConfig: expandDistributionConfig(d),
+		DistributionConfig: expandDistributionConfig(ctx, d),
 		IfMatch:            aws.String(d.Get("etag").(string)),
 	}
 
@@ -9,12 +9,12 @@ package cloudfront
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -26,10 +26,10 @@ import (
 // DistributionConfig structure.
 //
 // Used by the aws_cloudfront_distribution Create and Update functions.
-func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionConfig {
+func expandDistributionConfig(ctx context.Context, d *schema.ResourceData) *cloudfront.DistributionConfig {
 	distributionConfig := &cloudfront.DistributionConfig{
 		CacheBehaviors:       expandCacheBehaviors(d.Get("ordered_cache_behavior").([]interface{})),
-		CallerReference:      aws.String(id.UniqueId()),
+		CallerReference:      aws.String(create.UniqueId(ctx)),
 		Comment:              aws.String(d.Get("comment").(string)),
 		CustomErrorResponses: ExpandCustomErrorResponses(d.Get("custom_error_response").(*schema.Set)),
 		DefaultCacheBehavior: ExpandDefaultCacheBehavior(d.Get("default_cache_behavior").([]interface{})[0].(map[string]interface{})),
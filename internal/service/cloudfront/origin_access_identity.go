@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -61,7 +60,7 @@ func resourceOriginAccessIdentityCreate(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 	params := &cloudfront.CreateCloudFrontOriginAccessIdentityInput{
-		CloudFrontOriginAccessIdentityConfig: expandOriginAccessIdentityConfig(d),
+		CloudFrontOriginAccessIdentityConfig: expandOriginAccessIdentityConfig(ctx, d),
 	}
 
 	resp, err := conn.CreateCloudFrontOriginAccessIdentityWithContext(ctx, params)
@@ -112,7 +111,7 @@ func resourceOriginAccessIdentityUpdate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 	params := &cloudfront.UpdateCloudFrontOriginAccessIdentityInput{
 		Id:                                   aws.String(d.Id()),
-		CloudFrontOriginAccessIdentityConfig: expandOriginAccessIdentityConfig(d),
+		CloudFrontOriginAccessIdentityConfig: expandOriginAccessIdentityConfig(ctx, d),
 		IfMatch:                              aws.String(d.Get("etag").(string)),
 	}
 	_, err := conn.UpdateCloudFrontOriginAccessIdentityWithContext(ctx, params)
@@ -137,13 +136,13 @@ func resourceOriginAccessIdentityDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-func expandOriginAccessIdentityConfig(d *schema.ResourceData) *cloudfront.OriginAccessIdentityConfig {
+func expandOriginAccessIdentityConfig(ctx context.Context, d *schema.ResourceData) *cloudfront.OriginAccessIdentityConfig {
 	originAccessIdentityConfig := &cloudfront.OriginAccessIdentityConfig{
 		Comment: aws.String(d.Get("comment").(string)),
 	}
 	// This sets CallerReference if it's still pending computation (ie: new resource)
 	if v, ok := d.GetOk("caller_reference"); !ok {
-		originAccessIdentityConfig.CallerReference = aws.String(id.UniqueId())
+		originAccessIdentityConfig.CallerReference = aws.String(create.UniqueId(ctx))
 	} else {
 		originAccessIdentityConfig.CallerReference = aws.String(v.(string))
 	}
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -73,13 +72,13 @@ func resourcePublicKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 	if v, ok := d.GetOk("name"); ok {
 		d.Set("name", v.(string))
 	} else if v, ok := d.GetOk("name_prefix"); ok {
-		d.Set("name", id.PrefixedUniqueId(v.(string)))
+		d.Set("name", create.PrefixedUniqueId(ctx, v.(string)))
 	} else {
-		d.Set("name", id.PrefixedUniqueId("tf-"))
+		d.Set("name", create.PrefixedUniqueId(ctx, "tf-"))
 	}
 
 	request := &cloudfront.CreatePublicKeyInput{
-		PublicKeyConfig: expandPublicKeyConfig(d),
+		PublicKeyConfig: expandPublicKeyConfig(ctx, d),
 	}
 
 	log.Println("[DEBUG] Create CloudFront PublicKey:", request)
@@ -137,7 +136,7 @@ func resourcePublicKeyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	request := &cloudfront.UpdatePublicKeyInput{
 		Id:              aws.String(d.Id()),
-		PublicKeyConfig: expandPublicKeyConfig(d),
+		PublicKeyConfig: expandPublicKeyConfig(ctx, d),
 		IfMatch:         aws.String(d.Get("etag").(string)),
 	}
 
@@ -169,7 +168,7 @@ func resourcePublicKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
-func expandPublicKeyConfig(d *schema.ResourceData) *cloudfront.PublicKeyConfig {
+func expandPublicKeyConfig(ctx context.Context, d *schema.ResourceData) *cloudfront.PublicKeyConfig {
 	publicKeyConfig := &cloudfront.PublicKeyConfig{
 		EncodedKey: aws.String(d.Get("encoded_key").(string)),
 		Name:       aws.String(d.Get("name").(string)),
@@ -182,7 +181,7 @@ func expandPublicKeyConfig(d *schema.ResourceData) *cloudfront.PublicKeyConfig {
 	if v, ok := d.GetOk("caller_reference"); ok {
 		publicKeyConfig.CallerReference = aws.String(v.(string))
 	} else {
-		publicKeyConfig.CallerReference = aws.String(id.UniqueId())
+		publicKeyConfig.CallerReference = aws.String(create.UniqueId(ctx))
 	}
 
 	return publicKeyConfig
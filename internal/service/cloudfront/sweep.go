@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -24,17 +24,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -42,22 +42,22 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -65,7 +65,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -73,12 +73,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -14,13 +14,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
 		Name:         "aws_cloudhsm_v2_cluster",
 		F:            sweepClusters,
 		Dependencies: []string{"aws_cloudhsm_v2_hsm"},
 	})
 
-	resource.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
 		Name: "aws_cloudhsm_v2_hsm",
 		F:    sweepHSMs,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
 		Name: "aws_cloudtrail",
 		F:    sweeps,
 	})
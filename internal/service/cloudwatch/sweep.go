@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
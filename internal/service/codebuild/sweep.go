@@ -15,17 +15,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
 		Name: "aws_codebuild_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
 		Name: "aws_codebuild_source_credential",
 		F:    sweepSourceCredentials,
 	})
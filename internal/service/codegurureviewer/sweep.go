@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
 		Name: "aws_codegurureviewer",
 		F:    sweepAssociations,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codepipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codepipeline", &resource.Sweeper{
 		Name: "aws_codepipeline",
 		F:    sweepPipelines,
 	})
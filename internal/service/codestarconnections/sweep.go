@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
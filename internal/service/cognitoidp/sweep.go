@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
 		Name: "aws_cognito_user_pool_domain",
 		F:    sweepUserPoolDomains,
 	})
 
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    sweepUserPools,
 		Dependencies: []string{
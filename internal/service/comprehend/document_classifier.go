@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -274,7 +273,7 @@ func resourceDocumentClassifierCreate(ctx context.Context, d *schema.ResourceDat
 	var versionName *string
 	raw := d.GetRawConfig().GetAttr("version_name")
 	if raw.IsNull() {
-		versionName = aws.String(create.Name("", d.Get("version_name_prefix").(string)))
+		versionName = aws.String(create.Name(ctx, "", d.Get("version_name_prefix").(string)))
 	} else if v := raw.AsString(); v != "" {
 		versionName = aws.String(v)
 	}
@@ -344,7 +343,7 @@ func resourceDocumentClassifierUpdate(ctx context.Context, d *schema.ResourceDat
 		if d.HasChange("version_name") {
 			versionName = aws.String(d.Get("version_name").(string))
 		} else if v := d.Get("version_name_prefix").(string); v != "" {
-			versionName = aws.String(create.Name("", d.Get("version_name_prefix").(string)))
+			versionName = aws.String(create.Name(ctx, "", d.Get("version_name_prefix").(string)))
 		}
 
 		diags := documentClassifierPublishVersion(ctx, conn, d, versionName, create.ErrActionUpdating, d.Timeout(schema.TimeoutUpdate), awsClient)
@@ -465,7 +464,7 @@ func documentClassifierPublishVersion(ctx context.Context, conn *comprehend.Clie
 		OutputDataConfig:       expandDocumentClassifierOutputDataConfig(d.Get("output_data_config").([]interface{})),
 		VersionName:            versionName,
 		VpcConfig:              expandVPCConfig(d.Get("vpc_config").([]interface{})),
-		ClientRequestToken:     aws.String(id.UniqueId()),
+		ClientRequestToken:     aws.String(create.UniqueId(ctx)),
 		Tags:                   getTagsIn(ctx),
 	}
 
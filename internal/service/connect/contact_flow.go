@@ -112,8 +112,10 @@ func resourceContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 		// Grab an exclusive lock so that we're only reading one contact flow into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(contactFlowMutexKey)
-		defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, contactFlowMutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating Connect Contact Flow (%s): %s", name, err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, contactFlowMutexKey)
 		file, err := resourceContactFlowLoadFileContent(filename)
 		if err != nil {
 			return diag.Errorf("unable to load %q: %s", filename, err)
@@ -214,8 +216,10 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 			// Grab an exclusive lock so that we're only reading one contact flow into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(contactFlowMutexKey)
-			defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
+			if err := conns.GlobalKeyedLock.Lock(ctx, contactFlowMutexKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Connect Contact Flow content (%s): %s", d.Id(), err)
+			}
+			defer conns.GlobalKeyedLock.Unlock(ctx, contactFlowMutexKey)
 			file, err := resourceContactFlowLoadFileContent(filename)
 			if err != nil {
 				return diag.Errorf("unable to load %q: %s", filename, err)
@@ -106,8 +106,10 @@ func resourceContactFlowModuleCreate(ctx context.Context, d *schema.ResourceData
 		// Grab an exclusive lock so that we're only reading one contact flow module into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(contactFlowModuleMutexKey)
-		defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, contactFlowModuleMutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating Connect Contact Flow Module (%s): %s", name, err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, contactFlowModuleMutexKey)
 		file, err := resourceContactFlowModuleLoadFileContent(filename)
 		if err != nil {
 			return diag.Errorf("unable to load %q: %s", filename, err)
@@ -207,8 +209,10 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 			// Grab an exclusive lock so that we're only reading one contact flow module into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(contactFlowModuleMutexKey)
-			defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
+			if err := conns.GlobalKeyedLock.Lock(ctx, contactFlowModuleMutexKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Connect Contact Flow Module content (%s): %s", d.Id(), err)
+			}
+			defer conns.GlobalKeyedLock.Unlock(ctx, contactFlowModuleMutexKey)
 			file, err := resourceContactFlowModuleLoadFileContent(filename)
 			if err != nil {
 				return diag.Errorf("unable to load %q: %s", filename, err)
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
 		Name: "aws_connect_instance",
 		F:    sweepInstance,
 	})
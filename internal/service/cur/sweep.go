@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
 		Name: "aws_dataexchange_data_set",
 		F:    sweepDataSets,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    sweepClusters,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
 		Name: "aws_codedeploy_app",
 		F:    sweepApps,
 	})
@@ -17,17 +17,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -43,13 +43,13 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
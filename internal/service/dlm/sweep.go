@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
 		Name: "aws_dlm_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
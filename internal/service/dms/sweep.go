@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
 
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 	})
@@ -10,11 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -201,9 +201,9 @@ func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		input.DBInstanceIdentifier = aws.String(v.(string))
 	} else {
 		if v, ok := d.GetOk("identifier_prefix"); ok {
-			input.DBInstanceIdentifier = aws.String(id.PrefixedUniqueId(v.(string)))
+			input.DBInstanceIdentifier = aws.String(create.PrefixedUniqueId(ctx, v.(string)))
 		} else {
-			input.DBInstanceIdentifier = aws.String(id.PrefixedUniqueId("tf-"))
+			input.DBInstanceIdentifier = aws.String(create.PrefixedUniqueId(ctx, "tf-"))
 		}
 	}
 
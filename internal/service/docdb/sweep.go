@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepDBSubnetGroups,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
 		Name: "aws_docdb_cluster",
 		F:    sweepDBClusters,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepDBClusterSnapshots,
 		Dependencies: []string{
@@ -54,12 +54,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepDBInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepDBClusterParameterGroups,
 		Dependencies: []string{
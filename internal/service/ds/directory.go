@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -29,7 +30,7 @@ const (
 	directoryApplicationDeauthorizedPropagationTimeout = 2 * time.Minute
 )
 
-// @SDKResource("aws_directory_service_directory", name="Directory")
+// @SDKResource("aws_directory_service_directory", name="Directory", interceptors=directoryCreateConcurrencyLimitInterceptor)
 // @Tags(identifierAttribute="id")
 func ResourceDirectory() *schema.Resource {
 	return &schema.Resource{
@@ -202,6 +203,10 @@ func ResourceDirectory() *schema.Resource {
 	}
 }
 
+func directoryCreateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.DS, "CreateDirectory", 0, interceptor.Create)
+}
+
 func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DSConn(ctx)
@@ -0,0 +1,123 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportDiscoverers returns the functions that find the import IDs of existing resources.
+func (p *servicePackage) ImportDiscoverers(ctx context.Context) []*types.ServicePackageImportDiscoverer {
+	return []*types.ServicePackageImportDiscoverer{
+		{
+			Discover: discoverInstanceIDs,
+			TypeName: "aws_instance",
+		},
+		{
+			Discover: discoverInternetGatewayIDs,
+			TypeName: "aws_internet_gateway",
+		},
+		{
+			Discover: discoverSecurityGroupIDs,
+			TypeName: "aws_security_group",
+		},
+		{
+			Discover: discoverSubnetIDs,
+			TypeName: "aws_subnet",
+		},
+		{
+			Discover: discoverVPCIDs,
+			TypeName: "aws_vpc",
+		},
+	}
+}
+
+func discoverInstanceIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			NewFilter("instance-state-name", []string{
+				ec2.InstanceStateNamePending,
+				ec2.InstanceStateNameRunning,
+				ec2.InstanceStateNameStopping,
+				ec2.InstanceStateNameStopped,
+			}),
+		},
+	}
+
+	output, err := FindInstances(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *ec2.Instance) string {
+		return aws.StringValue(v.InstanceId)
+	}), nil
+}
+
+func discoverInternetGatewayIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindInternetGateways(ctx, conn, &ec2.DescribeInternetGatewaysInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *ec2.InternetGateway) string {
+		return aws.StringValue(v.InternetGatewayId)
+	}), nil
+}
+
+func discoverSecurityGroupIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Default security groups are managed by aws_default_security_group.
+	output = tfslices.Filter(output, func(v *ec2.SecurityGroup) bool {
+		return aws.StringValue(v.GroupName) != DefaultSecurityGroupName
+	})
+
+	return tfslices.ApplyToAll(output, func(v *ec2.SecurityGroup) string {
+		return aws.StringValue(v.GroupId)
+	}), nil
+}
+
+func discoverSubnetIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindSubnets(ctx, conn, &ec2.DescribeSubnetsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *ec2.Subnet) string {
+		return aws.StringValue(v.SubnetId)
+	}), nil
+}
+
+func discoverVPCIDs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindVPCs(ctx, conn, &ec2.DescribeVpcsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v *ec2.Vpc) string {
+		return aws.StringValue(v.VpcId)
+	}), nil
+}
@@ -948,6 +948,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"ec2:CreateVpc", "ec2:CreateTags", "ec2:ModifyVpcAttribute", "ec2:ModifyVpcTenancy", "ec2:AssociateVpcCidrBlock"},
+				Read:   []string{"ec2:DescribeVpcs", "ec2:DescribeVpcAttribute", "ec2:DescribeNetworkAcls", "ec2:DescribeRouteTables", "ec2:DescribeSecurityGroups"},
+				Update: []string{"ec2:ModifyVpcAttribute", "ec2:ModifyVpcTenancy", "ec2:AssociateVpcCidrBlock", "ec2:DisassociateVpcCidrBlock", "ec2:CreateTags", "ec2:DeleteTags"},
+				Delete: []string{"ec2:DeleteVpc", "ec2:DescribeVpcs", "ec2:GetIpamPoolAllocations"},
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
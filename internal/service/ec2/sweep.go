@@ -21,7 +21,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -29,17 +29,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -47,17 +47,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -65,7 +65,7 @@ func init() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -73,12 +73,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
@@ -86,12 +86,12 @@ func init() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -99,7 +99,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -109,7 +109,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -117,7 +117,7 @@ func init() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -128,7 +128,7 @@ func init() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -137,17 +137,17 @@ func init() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -165,12 +165,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -182,12 +182,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -195,17 +195,17 @@ func init() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
 
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    sweepSubnets,
 		Dependencies: []string{
@@ -257,17 +257,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -278,12 +278,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -291,7 +291,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -300,12 +300,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -313,7 +313,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -322,12 +322,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -344,12 +344,12 @@ func init() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -358,27 +358,27 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
@@ -34,6 +34,7 @@ const (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="ec2:CreateVpc;ec2:CreateTags;ec2:ModifyVpcAttribute;ec2:ModifyVpcTenancy;ec2:AssociateVpcCidrBlock", read="ec2:DescribeVpcs;ec2:DescribeVpcAttribute;ec2:DescribeNetworkAcls;ec2:DescribeRouteTables;ec2:DescribeSecurityGroups", update="ec2:ModifyVpcAttribute;ec2:ModifyVpcTenancy;ec2:AssociateVpcCidrBlock;ec2:DisassociateVpcCidrBlock;ec2:CreateTags;ec2:DeleteTags", delete="ec2:DeleteVpc;ec2:DescribeVpcs;ec2:GetIpamPoolAllocations")
 func ResourceVPC() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
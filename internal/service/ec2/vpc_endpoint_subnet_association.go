@@ -67,8 +67,10 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 	// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
 	// Prevent concurrent subnet association requests and delay between requests.
 	mk := "vpc_endpoint_subnet_association_" + endpointID
-	conns.GlobalMutexKV.Lock(mk)
-	defer conns.GlobalMutexKV.Unlock(mk)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mk, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Endpoint Subnet Association (%s): %s", id, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mk)
 
 	c := &retry.StateChangeConf{
 		Delay:   1 * time.Minute,
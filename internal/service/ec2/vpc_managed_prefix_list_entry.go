@@ -64,8 +64,10 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return nil, err
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
 
@@ -137,8 +139,10 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return nil, err
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
 
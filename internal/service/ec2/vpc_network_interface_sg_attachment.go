@@ -48,8 +48,10 @@ func resourceNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "attaching EC2 Security Group (%s) to EC2 Network Interface (%s): %s", sgID, networkInterfaceID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
 
@@ -125,8 +127,10 @@ func resourceNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "detaching EC2 Security Group (%s) from EC2 Network Interface (%s): %s", sgID, networkInterfaceID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
 
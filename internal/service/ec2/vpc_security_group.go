@@ -181,7 +181,7 @@ var (
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	inputC := &ec2.CreateSecurityGroupInput{
 		GroupName:         aws.String(name),
 		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeSecurityGroup),
@@ -415,8 +415,10 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 // looking for a rule depending on this security group. Otherwise, it will only look at
 // groups that this group knows about.
 func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.EC2, id string, searchAll bool) error {
-	conns.GlobalMutexKV.Lock(id)
-	defer conns.GlobalMutexKV.Unlock(id)
+	if err := conns.GlobalKeyedLock.Lock(ctx, id, 0); err != nil {
+		return err
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, id)
 
 	rules, err := rulesInSGsTouchingThis(ctx, conn, id, searchAll)
 	if err != nil {
@@ -152,8 +152,10 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
-	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+	if err := conns.GlobalKeyedLock.Lock(ctx, securityGroupID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("authorizing Security Group (%s) Rule: %s", securityGroupID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
 
@@ -314,8 +316,10 @@ func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange("description") {
 		securityGroupID := d.Get("security_group_id").(string)
 
-		conns.GlobalMutexKV.Lock(securityGroupID)
-		defer conns.GlobalMutexKV.Unlock(securityGroupID)
+		if err := conns.GlobalKeyedLock.Lock(ctx, securityGroupID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating Security Group (%s) Rule (%s) description: %s", securityGroupID, d.Id(), err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, securityGroupID)
 
 		sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
 
@@ -356,8 +360,10 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
-	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+	if err := conns.GlobalKeyedLock.Lock(ctx, securityGroupID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("revoking Security Group (%s) Rule (%s): %s", securityGroupID, d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
 
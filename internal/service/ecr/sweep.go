@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
 		Name: "aws_ecr_repository",
 		F:    sweepRepositories,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
 		Name: "aws_ecrpublic_repository",
 		F:    sweepRepositories,
 	})
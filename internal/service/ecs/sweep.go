@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
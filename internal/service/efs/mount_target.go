@@ -112,8 +112,10 @@ func resourceMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	fsID := d.Get("file_system_id").(string)
 	mtKey := "efs-mt-" + fsID + "-" + az
-	conns.GlobalMutexKV.Lock(mtKey)
-	defer conns.GlobalMutexKV.Unlock(mtKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mtKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EFS Mount Target (%s): %s", fsID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mtKey)
 
 	input := &efs.CreateMountTargetInput{
 		FileSystemId: aws.String(fsID),
@@ -16,13 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_eks_cluster", name="Cluster")
+// @SDKResource("aws_eks_cluster", name="Cluster", interceptors=clusterCreateConcurrencyLimitInterceptor;clusterDeleteConcurrencyLimitInterceptor)
 // @Tags(identifierAttribute="arn")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
@@ -288,6 +289,14 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
+func clusterCreateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.EKS, "CreateCluster", 0, interceptor.Create)
+}
+
+func clusterDeleteConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.EKS, "DeleteCluster", 0, interceptor.Delete)
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn(ctx)
 
@@ -10,11 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -112,7 +112,7 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	fargateProfileName := d.Get("fargate_profile_name").(string)
 	profileID := FargateProfileCreateResourceID(clusterName, fargateProfileName)
 	input := &eks.CreateFargateProfileInput{
-		ClientRequestToken:  aws.String(id.UniqueId()),
+		ClientRequestToken:  aws.String(create.UniqueId(ctx)),
 		ClusterName:         aws.String(clusterName),
 		FargateProfileName:  aws.String(fargateProfileName),
 		PodExecutionRoleArn: aws.String(d.Get("pod_execution_role_arn").(string)),
@@ -123,8 +123,10 @@ func resourceFargateProfileCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// mutex lock for creation/deletion serialization
 	mutexKey := fmt.Sprintf("%s-fargate-profiles", clusterName)
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Fargate Profile (%s): %s", profileID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
 		_, err := conn.CreateFargateProfileWithContext(ctx, input)
@@ -223,8 +225,10 @@ func resourceFargateProfileDelete(ctx context.Context, d *schema.ResourceData, m
 
 	// mutex lock for creation/deletion serialization
 	mutexKey := fmt.Sprintf("%s-fargate-profiles", d.Get("cluster_name").(string))
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EKS Fargate Profile (%s): %s", d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
 	_, err = conn.DeleteFargateProfileWithContext(ctx, &eks.DeleteFargateProfileInput{
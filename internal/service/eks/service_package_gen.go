@@ -9,6 +9,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	eks_sdkv1 "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -73,6 +74,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Interceptors: interceptor.SDKResourceItems{
+				clusterCreateConcurrencyLimitInterceptor(),
+				clusterDeleteConcurrencyLimitInterceptor(),
+			},
 		},
 		{
 			Factory:  ResourceFargateProfile,
@@ -24,7 +24,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -16,13 +16,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
@@ -18,7 +18,6 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -274,9 +273,9 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 		elbName = v.(string)
 	} else {
 		if v, ok := d.GetOk("name_prefix"); ok {
-			elbName = id.PrefixedUniqueId(v.(string))
+			elbName = create.PrefixedUniqueId(ctx, v.(string))
 		} else {
-			elbName = id.PrefixedUniqueId("tf-lb-")
+			elbName = create.PrefixedUniqueId(ctx, "tf-lb-")
 		}
 	}
 
@@ -17,7 +17,6 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -309,9 +308,9 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
 	} else if v, ok := d.GetOk("name_prefix"); ok {
-		name = id.PrefixedUniqueId(v.(string))
+		name = create.PrefixedUniqueId(ctx, v.(string))
 	} else {
-		name = id.PrefixedUniqueId("tf-lb-")
+		name = create.PrefixedUniqueId(ctx, "tf-lb-")
 	}
 	d.Set("name", name)
 
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
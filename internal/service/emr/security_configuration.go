@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -67,9 +68,9 @@ func resourceSecurityConfigurationCreate(ctx context.Context, d *schema.Resource
 		emrSCName = v.(string)
 	} else {
 		if v, ok := d.GetOk("name_prefix"); ok {
-			emrSCName = id.PrefixedUniqueId(v.(string))
+			emrSCName = create.PrefixedUniqueId(ctx, v.(string))
 		} else {
-			emrSCName = id.PrefixedUniqueId("tf-emr-sc-")
+			emrSCName = create.PrefixedUniqueId(ctx, "tf-emr-sc-")
 		}
 	}
 
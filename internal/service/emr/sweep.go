@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
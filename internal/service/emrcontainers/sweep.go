@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 
-	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
 		Name: "aws_emrcontainers_job_template",
 		F:    sweepJobTemplates,
 	})
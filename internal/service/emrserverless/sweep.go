@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrserverless_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrserverless_application", &resource.Sweeper{
 		Name: "aws_emrserverless_application",
 		F:    sweepApplications,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -42,17 +42,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -60,7 +60,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
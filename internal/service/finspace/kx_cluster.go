@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/aws/aws-sdk-go-v2/service/finspace/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -392,7 +391,7 @@ func resourceKxClusterCreate(ctx context.Context, d *schema.ResourceData, meta i
 		ReleaseLabel:          aws.String(d.Get("release_label").(string)),
 		AzMode:                types.KxAzMode(d.Get("az_mode").(string)),
 		CapacityConfiguration: expandCapacityConfiguration(d.Get("capacity_configuration").([]interface{})),
-		ClientToken:           aws.String(id.UniqueId()),
+		ClientToken:           aws.String(create.UniqueId(ctx)),
 		Tags:                  getTagsIn(ctx),
 	}
 
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/aws/aws-sdk-go-v2/service/finspace/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -93,7 +92,7 @@ func resourceKxDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta
 	in := &finspace.CreateKxDatabaseInput{
 		DatabaseName:  aws.String(d.Get("name").(string)),
 		EnvironmentId: aws.String(d.Get("environment_id").(string)),
-		ClientToken:   aws.String(id.UniqueId()),
+		ClientToken:   aws.String(create.UniqueId(ctx)),
 		Tags:          getTagsIn(ctx),
 	}
 
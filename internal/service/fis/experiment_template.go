@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/fis/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -285,7 +284,7 @@ func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 
 	input := &fis.CreateExperimentTemplateInput{
 		Actions:          expandExperimentTemplateActions(d.Get("action").(*schema.Set)),
-		ClientToken:      aws.String(id.UniqueId()),
+		ClientToken:      aws.String(create.UniqueId(ctx)),
 		Description:      aws.String(d.Get("description").(string)),
 		LogConfiguration: expandExperimentTemplateLogConfiguration(d.Get("log_configuration").([]interface{})),
 		RoleArn:          aws.String(d.Get("role_arn").(string)),
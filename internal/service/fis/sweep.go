@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fis_experiment_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fis_experiment_template", &resource.Sweeper{
 		Name: "aws_fis_experiment_template",
 		F:    sweepExperimentTemplates,
 	})
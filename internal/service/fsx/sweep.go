@@ -15,44 +15,44 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
 		Name: "aws_fsx_backup",
 		F:    sweepBackups,
 	})
 
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    sweepLustreFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
 		Name:         "aws_fsx_ontap_file_system",
 		F:            sweepOntapFileSystems,
 		Dependencies: []string{"aws_fsx_ontap_storage_virtual_machine"},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
 		Name:         "aws_fsx_ontap_storage_virtual_machine",
 		F:            sweepOntapStorageVirtualMachine,
 		Dependencies: []string{"aws_fsx_ontap_volume"},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
 		Name: "aws_fsx_ontap_volume",
 		F:    sweepOntapVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
 		Name: "aws_fsx_openzfs_file_system",
 		F:    sweepOpenZFSFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
 		Name: "aws_fsx_openzfs_volume",
 		F:    sweepOpenZFSVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    sweepWindowsFileSystems,
 		Dependencies: []string{
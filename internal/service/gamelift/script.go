@@ -110,8 +110,10 @@ func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if v, ok := d.GetOk("zip_file"); ok {
-		conns.GlobalMutexKV.Lock(scriptMutex)
-		defer conns.GlobalMutexKV.Unlock(scriptMutex)
+		if err := conns.GlobalKeyedLock.Lock(ctx, scriptMutex, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating GameLift Script: %s", err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, scriptMutex)
 
 		file, err := loadFileContent(v.(string))
 		if err != nil {
@@ -200,8 +202,10 @@ func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 		if d.HasChange("zip_file") {
 			if v, ok := d.GetOk("zip_file"); ok {
-				conns.GlobalMutexKV.Lock(scriptMutex)
-				defer conns.GlobalMutexKV.Unlock(scriptMutex)
+				if err := conns.GlobalKeyedLock.Lock(ctx, scriptMutex, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating GameLift Script: %s", err)
+				}
+				defer conns.GlobalKeyedLock.Unlock(ctx, scriptMutex)
 
 				file, err := loadFileContent(v.(string))
 				if err != nil {
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
 		Name: "aws_glacier_vault",
 		F:    sweepVaults,
 	})
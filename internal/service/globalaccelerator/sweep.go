@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_accelerator",
 		F:    sweepCustomRoutingAccelerators,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &resource.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_listener",
 		F:    sweepCustomRoutingListeners,
 		Dependencies: []string{
@@ -52,7 +52,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &resource.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_endpoint_group",
 		F:    sweepCustomRoutingEndpointGroups,
 	})
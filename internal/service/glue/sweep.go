@@ -17,62 +17,62 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
 
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
 
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
 
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
 
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
 
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
 		Name: "aws_glue_registry",
 		F:    sweepRegistry,
 	})
 
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
 		Name: "aws_glue_schema",
 		F:    sweepSchema,
 	})
 
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    sweepSecurityConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
 
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
 		Name: "aws_grafana_workspace",
 		F:    sweepWorkSpaces,
 	})
@@ -16,13 +16,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportDiscoverers returns the functions that find the import IDs of existing resources.
+func (p *servicePackage) ImportDiscoverers(ctx context.Context) []*types.ServicePackageImportDiscoverer {
+	return []*types.ServicePackageImportDiscoverer{
+		{
+			Discover: discoverGroupNames,
+			TypeName: "aws_iam_group",
+		},
+		{
+			Discover: discoverPolicyARNs,
+			TypeName: "aws_iam_policy",
+		},
+		{
+			Discover: discoverRoleNames,
+			TypeName: "aws_iam_role",
+		},
+		{
+			Discover: discoverUserNames,
+			TypeName: "aws_iam_user",
+		},
+	}
+}
+
+func discoverGroupNames(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	var output []string
+
+	err := conn.ListGroupsPagesWithContext(ctx, &iam.ListGroupsInput{}, func(page *iam.ListGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Groups {
+			if v != nil {
+				output = append(output, aws.StringValue(v.GroupName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func discoverPolicyARNs(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	input := &iam.ListPoliciesInput{
+		Scope: aws.String(iam.PolicyScopeTypeLocal),
+	}
+
+	output, err := findPolicies(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.ApplyToAll(output, func(v *iam.Policy) string {
+		return aws.StringValue(v.Arn)
+	}), nil
+}
+
+func discoverRoleNames(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	var output []string
+
+	err := conn.ListRolesPagesWithContext(ctx, &iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Roles {
+			if v == nil {
+				continue
+			}
+
+			// Service-linked roles and roles reserved for IAM Identity Center are managed by AWS.
+			if path := aws.StringValue(v.Path); strings.HasPrefix(path, "/aws-service-role/") || strings.HasPrefix(path, "/aws-reserved/") {
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.RoleName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func discoverUserNames(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	output, err := FindUsers(ctx, conn, "", "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.ApplyToAll(output, func(v *iam.User) string {
+		return aws.StringValue(v.UserName)
+	}), nil
+}
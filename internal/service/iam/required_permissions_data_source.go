@@ -0,0 +1,231 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	requiredPermissionsOperationCreate = "create"
+	requiredPermissionsOperationDelete = "delete"
+	requiredPermissionsOperationRead   = "read"
+	requiredPermissionsOperationUpdate = "update"
+)
+
+var invalidSIDCharsRegexp = regexp.MustCompile(`[^0-9A-Za-z]`)
+
+func requiredPermissionsOperation_Values() []string {
+	return []string{
+		requiredPermissionsOperationCreate,
+		requiredPermissionsOperationDelete,
+		requiredPermissionsOperationRead,
+		requiredPermissionsOperationUpdate,
+	}
+}
+
+// @SDKDataSource("aws_iam_required_permissions")
+func DataSourceRequiredPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRequiredPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"denied_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(requiredPermissionsOperation_Values(), false),
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"simulate_principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceRequiredPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+
+	operations := requiredPermissionsOperation_Values()
+	if v, ok := d.GetOk("operations"); ok && v.(*schema.Set).Len() > 0 {
+		operations = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	resourceIAMActions := requiredPermissionsResourceIAMActions(ctx, client.ServicePackages)
+	resourceTypes := flex.ExpandStringValueSet(d.Get("resource_types").(*schema.Set))
+	sort.Strings(resourceTypes)
+
+	var actions []string
+
+	for _, resourceType := range resourceTypes {
+		v, ok := resourceIAMActions[resourceType]
+
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "unknown resource type: %s", resourceType)
+		}
+
+		if v == nil {
+			diags = sdkdiag.AppendWarningf(diags, "resource type (%s) does not declare the IAM actions it needs; they are not included in the policy", resourceType)
+			continue
+		}
+
+		for _, operation := range operations {
+			actions = append(actions, v.Actions(operation)...)
+		}
+	}
+
+	slices.Sort(actions)
+	actions = slices.Compact(actions)
+
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+	}
+
+	// One statement per service prefix, e.g. "ec2".
+	var servicePrefix string
+
+	for _, action := range actions {
+		if v, _, _ := strings.Cut(action, ":"); len(doc.Statements) == 0 || v != servicePrefix {
+			servicePrefix = v
+			doc.Statements = append(doc.Statements, &IAMPolicyStatement{
+				// Statement IDs may only contain alphanumeric characters.
+				Sid:       invalidSIDCharsRegexp.ReplaceAllString(servicePrefix, ""),
+				Effect:    "Allow",
+				Actions:   []string{},
+				Resources: "*",
+			})
+		}
+
+		statement := doc.Statements[len(doc.Statements)-1]
+		statement.Actions = append(statement.Actions.([]string), action)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "formatting IAM Policy Document JSON: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("actions", actions)
+	d.Set("json", jsonString)
+
+	if v, ok := d.GetOk("simulate_principal_arn"); ok && len(actions) > 0 {
+		deniedActions, err := findDeniedActions(ctx, client.IAMConn(ctx), v.(string), actions)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "simulating IAM Principal Policy (%s): %s", v.(string), err)
+		}
+
+		d.Set("denied_actions", deniedActions)
+	} else {
+		d.Set("denied_actions", nil)
+	}
+
+	return diags
+}
+
+// requiredPermissionsResourceIAMActions returns the declared IAM actions, keyed by resource type, of all the resources in the service packages.
+// Resources that do not declare their IAM actions have a nil value.
+func requiredPermissionsResourceIAMActions(ctx context.Context, servicePackages map[string]conns.ServicePackage) map[string]*types.ServicePackageResourceIAMActions {
+	resourceIAMActions := make(map[string]*types.ServicePackageResourceIAMActions)
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			resourceIAMActions[v.TypeName] = v.IAMActions
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			resourceIAMActions[response.TypeName] = v.IAMActions
+		}
+	}
+
+	return resourceIAMActions
+}
+
+// findDeniedActions returns the actions that are not allowed for the principal by its identity-based policies.
+func findDeniedActions(ctx context.Context, conn *iam.IAM, principalARN string, actions []string) ([]string, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     aws.StringSlice(actions),
+		MaxItems:        aws.Int64(1000),
+		PolicySourceArn: aws.String(principalARN),
+	}
+
+	var deniedActions []string
+
+	for {
+		output, err := conn.SimulatePrincipalPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.EvaluationResults {
+			if aws.StringValue(v.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				deniedActions = append(deniedActions, aws.StringValue(v.EvalActionName))
+			}
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	sort.Strings(deniedActions)
+
+	return deniedActions, nil
+}
@@ -0,0 +1,153 @@
+package iam_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMRequiredPermissionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_required_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredPermissionsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "14"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sns:CreateTopic"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sqs:DeleteQueue"),
+					resource.TestCheckResourceAttr(dataSourceName, "denied_actions.#", "0"),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"Sid": "sqs"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRequiredPermissionsDataSource_operations(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_required_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredPermissionsDataSourceConfig_operations,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "actions.0", "sqs:GetQueueAttributes"),
+					resource.TestCheckResourceAttr(dataSourceName, "actions.1", "sqs:ListQueueTags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRequiredPermissionsDataSource_unknownResourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRequiredPermissionsDataSourceConfig_unknownResourceType,
+				ExpectError: regexp.MustCompile(`unknown resource type: aws_not_a_resource`),
+			},
+		},
+	})
+}
+
+func TestAccIAMRequiredPermissionsDataSource_simulatePrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_required_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredPermissionsDataSourceConfig_simulatePrincipal(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "denied_actions.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "denied_actions.0", "sns:CreateTopic"),
+					resource.TestCheckResourceAttr(dataSourceName, "denied_actions.1", "sns:SetTopicAttributes"),
+					resource.TestCheckResourceAttr(dataSourceName, "denied_actions.2", "sns:TagResource"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRequiredPermissionsDataSourceConfig_basic = `
+data "aws_iam_required_permissions" "test" {
+  resource_types = ["aws_sns_topic", "aws_sqs_queue"]
+}
+`
+
+const testAccRequiredPermissionsDataSourceConfig_operations = `
+data "aws_iam_required_permissions" "test" {
+  resource_types = ["aws_sqs_queue"]
+  operations     = ["read"]
+}
+`
+
+const testAccRequiredPermissionsDataSourceConfig_unknownResourceType = `
+data "aws_iam_required_permissions" "test" {
+  resource_types = ["aws_not_a_resource"]
+}
+`
+
+func testAccRequiredPermissionsDataSourceConfig_simulatePrincipal(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ec2.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+
+  inline_policy {
+    name = %[1]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = ["sns:GetTopicAttributes", "sns:ListTagsForResource"]
+        Effect   = "Allow"
+        Resource = "*"
+      }]
+    })
+  }
+}
+
+data "aws_iam_required_permissions" "test" {
+  resource_types         = ["aws_sns_topic"]
+  operations             = ["create"]
+  simulate_principal_arn = aws_iam_role.test.arn
+}
+`, rName)
+}
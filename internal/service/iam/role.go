@@ -37,6 +37,7 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags
+// @IAMActions(create="iam:CreateRole;iam:TagRole;iam:PutRolePolicy;iam:AttachRolePolicy;iam:PutRolePermissionsBoundary", read="iam:GetRole;iam:ListRolePolicies;iam:GetRolePolicy;iam:ListAttachedRolePolicies", update="iam:UpdateRole;iam:UpdateRoleDescription;iam:UpdateAssumeRolePolicy;iam:PutRolePermissionsBoundary;iam:DeleteRolePermissionsBoundary;iam:PutRolePolicy;iam:DeleteRolePolicy;iam:AttachRolePolicy;iam:DetachRolePolicy;iam:TagRole;iam:UntagRole", delete="iam:ListInstanceProfilesForRole;iam:RemoveRoleFromInstanceProfile;iam:ListAttachedRolePolicies;iam:DetachRolePolicy;iam:ListRolePolicies;iam:DeleteRolePolicy;iam:DeleteRole")
 func ResourceRole() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoleCreate,
@@ -186,7 +187,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "assume_role_policy (%s) is invalid JSON: %s", assumeRolePolicy, err)
 	}
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &iam.CreateRoleInput{
 		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
 		Path:                     aws.String(d.Get("path").(string)),
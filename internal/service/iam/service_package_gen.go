@@ -61,6 +61,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
 		},
+		{
+			Factory:  DataSourceRequiredPermissions,
+			TypeName: "aws_iam_required_permissions",
+		},
 		{
 			Factory:  DataSourceRole,
 			TypeName: "aws_iam_role",
@@ -153,6 +157,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role",
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"iam:CreateRole", "iam:TagRole", "iam:PutRolePolicy", "iam:AttachRolePolicy", "iam:PutRolePermissionsBoundary"},
+				Read:   []string{"iam:GetRole", "iam:ListRolePolicies", "iam:GetRolePolicy", "iam:ListAttachedRolePolicies"},
+				Update: []string{"iam:UpdateRole", "iam:UpdateRoleDescription", "iam:UpdateAssumeRolePolicy", "iam:PutRolePermissionsBoundary", "iam:DeleteRolePermissionsBoundary", "iam:PutRolePolicy", "iam:DeleteRolePolicy", "iam:AttachRolePolicy", "iam:DetachRolePolicy", "iam:TagRole", "iam:UntagRole"},
+				Delete: []string{"iam:ListInstanceProfilesForRole", "iam:RemoveRoleFromInstanceProfile", "iam:ListAttachedRolePolicies", "iam:DetachRolePolicy", "iam:ListRolePolicies", "iam:DeleteRolePolicy", "iam:DeleteRole"},
+			},
 		},
 		{
 			Factory:  ResourceRolePolicy,
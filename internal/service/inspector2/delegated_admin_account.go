@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -61,7 +60,7 @@ func resourceDelegatedAdminAccountCreate(ctx context.Context, d *schema.Resource
 
 	in := &inspector2.EnableDelegatedAdminAccountInput{
 		DelegatedAdminAccountId: aws.String(d.Get("account_id").(string)),
-		ClientToken:             aws.String(id.UniqueId()),
+		ClientToken:             aws.String(create.UniqueId(ctx)),
 	}
 
 	out, err := conn.EnableDelegatedAdminAccount(ctx, in)
@@ -114,8 +114,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return nil
 	}
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
-	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
+	if err := conns.GlobalKeyedLock.Lock(ctx, orgConfigMutex, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, orgConfigMutex)
 
 	log.Printf("[DEBUG] Updating Inspector2 Organization Configuration (%s): %#v", d.Id(), in)
 	_, err := conn.UpdateOrganizationConfiguration(ctx, in)
@@ -133,8 +135,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
 
-	conns.GlobalMutexKV.Lock(orgConfigMutex)
-	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
+	if err := conns.GlobalKeyedLock.Lock(ctx, orgConfigMutex, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, orgConfigMutex)
 
 	in := &inspector2.UpdateOrganizationConfigurationInput{
 		AutoEnable: &types.AutoEnable{
@@ -8,10 +8,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/internetmonitor"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -118,7 +118,7 @@ func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	monitorName := d.Get("monitor_name").(string)
 	input := &internetmonitor.CreateMonitorInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		MonitorName: aws.String(monitorName),
 		Tags:        getTagsIn(ctx),
 	}
@@ -154,7 +154,7 @@ func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != internetmonitor.MonitorConfigStateActive {
 		input := &internetmonitor.UpdateMonitorInput{
-			ClientToken: aws.String(id.UniqueId()),
+			ClientToken: aws.String(create.UniqueId(ctx)),
 			MonitorName: aws.String(d.Id()),
 			Status:      aws.String(v.(string)),
 		}
@@ -208,7 +208,7 @@ func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &internetmonitor.UpdateMonitorInput{
-			ClientToken: aws.String(id.UniqueId()),
+			ClientToken: aws.String(create.UniqueId(ctx)),
 			MonitorName: aws.String(d.Id()),
 		}
 
@@ -263,7 +263,7 @@ func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).InternetMonitorConn(ctx)
 
 	input := &internetmonitor.UpdateMonitorInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		MonitorName: aws.String(d.Id()),
 		Status:      aws.String(internetmonitor.MonitorConfigStateInactive),
 	}
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
 		Dependencies: []string{
@@ -25,12 +25,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -38,40 +38,40 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
 		Name:         "aws_iot_topic_rule",
 		F:            sweepTopicRules,
 		Dependencies: []string{"aws_iot_topic_rule_destination"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
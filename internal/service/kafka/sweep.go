@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -22,7 +22,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
 		Dependencies: []string{
@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
 		F:    sweepCustomPlugins,
 		Dependencies: []string{
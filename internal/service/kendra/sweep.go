@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
 		Name: "aws_kendra_index",
 		F:    sweepIndex,
 	})
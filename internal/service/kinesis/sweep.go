@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_stream", &resource.Sweeper{
 		Name: "aws_kinesis_stream",
 		F:    sweepStreams,
 	})
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
 		Name: "aws_kinesis_analytics_application",
 		F:    sweepApplications,
 	})
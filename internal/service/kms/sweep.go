@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kms_key", &resource.Sweeper{
 		Name: "aws_kms_key",
 		F:    sweepKeys,
 	})
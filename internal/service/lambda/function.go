@@ -444,8 +444,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if v, ok := d.GetOk("filename"); ok {
 		// Grab an exclusive lock so that we're only reading one function into memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364.
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 		zipFile, err := readFileContents(v.(string))
 
@@ -889,8 +891,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
-			conns.GlobalMutexKV.Lock(mutexKey)
-			defer conns.GlobalMutexKV.Unlock(mutexKey)
+			if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
+			defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 			zipFile, err := readFileContents(v.(string))
 
@@ -0,0 +1,41 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportDiscoverers returns the functions that find the import IDs of existing resources.
+func (p *servicePackage) ImportDiscoverers(ctx context.Context) []*itypes.ServicePackageImportDiscoverer {
+	return []*itypes.ServicePackageImportDiscoverer{
+		{
+			Discover: discoverFunctionNames,
+			TypeName: "aws_lambda_function",
+		},
+	}
+}
+
+func discoverFunctionNames(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	var output []string
+
+	pages := lambda.NewListFunctionsPaginator(conn, &lambda.ListFunctionsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Functions {
+			output = append(output, aws.ToString(v.FunctionName))
+		}
+	}
+
+	return output, nil
+}
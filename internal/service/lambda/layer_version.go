@@ -154,8 +154,10 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 
 	var layerContent *lambda.LayerVersionContentInput
 	if hasFilename {
-		conns.GlobalMutexKV.Lock(mutexLayerKey)
-		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		if err := conns.GlobalKeyedLock.Lock(ctx, mutexLayerKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating lambda layer: %s", err)
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, mutexLayerKey)
 		file, err := readFileContents(filename.(string))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "Unable to load %q: %s", filename.(string), err)
@@ -113,13 +113,15 @@ func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
 
 	functionName := d.Get("function_name").(string)
-	statementID := create.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
+	statementID := create.Name(ctx, d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
 
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
-	defer conns.GlobalMutexKV.Unlock(functionName)
+	if err := conns.GlobalKeyedLock.Lock(ctx, functionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Lambda Permission (%s/%s): %s", functionName, statementID, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, functionName)
 
 	input := &lambda.AddPermissionInput{
 		Action:       aws.String(d.Get("action").(string)),
@@ -247,8 +249,10 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
-	defer conns.GlobalMutexKV.Unlock(functionName)
+	if err := conns.GlobalKeyedLock.Lock(ctx, functionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "removing Lambda Permission (%s/%s): %s", functionName, d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, functionName)
 
 	input := &lambda.RemovePermissionInput{
 		FunctionName: aws.String(functionName),
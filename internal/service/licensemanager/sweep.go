@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
@@ -11,9 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
 )
@@ -94,9 +94,9 @@ func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("name"); ok {
 		kName = v.(string)
 	} else if v, ok := d.GetOk("name_prefix"); ok {
-		kName = id.PrefixedUniqueId(v.(string))
+		kName = create.PrefixedUniqueId(ctx, v.(string))
 	} else {
-		kName = id.UniqueId()
+		kName = create.UniqueId(ctx)
 	}
 
 	var pubKey string
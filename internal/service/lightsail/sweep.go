@@ -15,17 +15,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -117,8 +117,10 @@ func resourceMetricFilterPut(ctx context.Context, d *schema.ResourceData, meta i
 	// clashes, so use a mutex here (and on deletion) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, logGroupName)
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, 0); err != nil {
+		return diag.Errorf("putting CloudWatch Logs Metric Filter (%s): %s", name, err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	_, err := conn.PutMetricFilterWithContext(ctx, input)
 
@@ -165,8 +167,10 @@ func resourceMetricFilterDelete(ctx context.Context, d *schema.ResourceData, met
 	// clashes, so use a mutex here (and on creation) to serialise actions on
 	// log groups.
 	mutexKey := fmt.Sprintf(`log-group-%s`, d.Get(`log_group_name`))
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, 0); err != nil {
+		return diag.Errorf("deleting CloudWatch Logs Metric Filter (%s): %s", d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	log.Printf("[INFO] Deleting CloudWatch Logs Metric Filter: %s", d.Id())
 	_, err := conn.DeleteMetricFilterWithContext(ctx, &cloudwatchlogs.DeleteMetricFilterInput{
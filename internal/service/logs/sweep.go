@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
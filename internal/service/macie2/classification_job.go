@@ -577,8 +577,8 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 
 	input := &macie2.CreateClassificationJobInput{
-		ClientToken:     aws.String(id.UniqueId()),
-		Name:            aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string))),
+		ClientToken:     aws.String(create.UniqueId(ctx)),
+		Name:            aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))),
 		JobType:         aws.String(d.Get("job_type").(string)),
 		S3JobDefinition: expandS3JobDefinition(d.Get("s3_job_definition").([]interface{})),
 		Tags:            getTagsIn(ctx),
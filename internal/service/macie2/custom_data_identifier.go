@@ -107,7 +107,7 @@ func resourceCustomDataIdentifierCreate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 
 	input := &macie2.CreateCustomDataIdentifierInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Tags:        getTagsIn(ctx),
 	}
 
@@ -120,7 +120,7 @@ func resourceCustomDataIdentifierCreate(ctx context.Context, d *schema.ResourceD
 	if v, ok := d.GetOk("ignore_words"); ok {
 		input.IgnoreWords = flex.ExpandStringSet(v.(*schema.Set))
 	}
-	input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+	input.Name = aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string)))
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -135,8 +135,8 @@ func resourceFindingsFilterCreate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 
 	input := &macie2.CreateFindingsFilterInput{
-		ClientToken: aws.String(id.UniqueId()),
-		Name:        aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string))),
+		ClientToken: aws.String(create.UniqueId(ctx)),
+		Name:        aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))),
 		Action:      aws.String(d.Get("action").(string)),
 		Tags:        getTagsIn(ctx),
 	}
@@ -233,10 +233,10 @@ func resourceFindingsFilterUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if d.HasChange("name") {
-		input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+		input.Name = aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string)))
 	}
 	if d.HasChange("name_prefix") {
-		input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+		input.Name = aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string)))
 	}
 	if d.HasChange("description") {
 		input.Description = aws.String(d.Get("description").(string))
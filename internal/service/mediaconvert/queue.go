@@ -238,8 +238,10 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 func GetAccountClient(ctx context.Context, awsClient *conns.AWSClient) (*mediaconvert.MediaConvert, error) {
 	const mutexKey = `mediaconvertaccountconn`
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
+	if err := conns.GlobalKeyedLock.Lock(ctx, mutexKey, 0); err != nil {
+		return nil, err
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, mutexKey)
 
 	if awsClient.MediaConvertAccountConn != nil {
 		return awsClient.MediaConvertAccountConn, nil
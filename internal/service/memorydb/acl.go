@@ -77,7 +77,7 @@ func ResourceACL() *schema.Resource {
 func resourceACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &memorydb.CreateACLInput{
 		ACLName: aws.String(name),
 		Tags:    getTagsIn(ctx),
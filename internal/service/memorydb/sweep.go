@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
 		Name: "aws_memorydb_acl",
 		F:    sweepACLs,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
 		Name: "aws_memorydb_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
 		Name: "aws_memorydb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -52,7 +52,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
 		Name: "aws_memorydb_user",
 		F:    sweepUsers,
 		Dependencies: []string{
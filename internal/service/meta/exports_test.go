@@ -0,0 +1,6 @@
+package meta
+
+// Exports for use in tests only.
+var (
+	ImportBlockName = importBlockName
+)
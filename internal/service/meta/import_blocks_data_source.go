@@ -0,0 +1,214 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"golang.org/x/exp/slices"
+)
+
+// @FrameworkDataSource
+func newDataSourceImportBlocks(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceImportBlocks{}
+
+	return d, nil
+}
+
+type dataSourceImportBlocks struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceImportBlocks) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_import_blocks"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceImportBlocks) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"resource_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resources": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: importBlockAttrTypes,
+				},
+				Computed: true,
+			},
+			"service_packages": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceImportBlocks) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceImportBlocksData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() {
+		data.Region = types.StringValue(d.Meta().Region)
+	}
+
+	client := d.Meta().ForRegion(data.Region.ValueString())
+	resourceTypes := flex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypes)
+	servicePackageNames := flex.ExpandFrameworkStringValueSet(ctx, data.ServicePackages)
+	sort.Strings(servicePackageNames)
+
+	var blocks []importBlock
+	names := make(map[string]bool)
+
+	for _, servicePackageName := range servicePackageNames {
+		sp, ok := client.ServicePackages[servicePackageName]
+
+		if !ok {
+			response.Diagnostics.AddError(fmt.Sprintf("unknown service package: %s", servicePackageName), "")
+
+			return
+		}
+
+		v, ok := sp.(interface {
+			ImportDiscoverers(context.Context) []*itypes.ServicePackageImportDiscoverer
+		})
+
+		if !ok {
+			response.Diagnostics.AddError(fmt.Sprintf("service package (%s) does not support import discovery", servicePackageName), "")
+
+			return
+		}
+
+		for _, discoverer := range v.ImportDiscoverers(ctx) {
+			typeName := discoverer.TypeName
+
+			if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, typeName) {
+				continue
+			}
+
+			ids, err := discoverer.Discover(ctx, client)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("discovering %s resources in %s", typeName, data.Region.ValueString()), err.Error())
+
+				return
+			}
+
+			sort.Strings(ids)
+
+			for _, id := range ids {
+				blocks = append(blocks, importBlock{
+					ID:   types.StringValue(id),
+					To:   types.StringValue(typeName + "." + importBlockName(id, names)),
+					Type: types.StringValue(typeName),
+				})
+			}
+		}
+	}
+
+	resources, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: importBlockAttrTypes}, blocks)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Region.ValueString())
+	data.ImportBlocks = types.StringValue(renderImportBlocks(blocks))
+	data.Resources = resources
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+var (
+	importBlockAttrTypes = map[string]attr.Type{
+		"id":   types.StringType,
+		"to":   types.StringType,
+		"type": types.StringType,
+	}
+
+	invalidImportBlockNameCharsRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// importBlockName returns a resource name derived from the import ID that is not already in names.
+func importBlockName(id string, names map[string]bool) string {
+	name := strings.Trim(invalidImportBlockNameCharsRegexp.ReplaceAllString(strings.ToLower(id), "_"), "_")
+
+	// Resource names must start with a letter or underscore.
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	for i, n := 2, name; ; i++ {
+		if !names[name] {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", n, i)
+	}
+
+	names[name] = true
+
+	return name
+}
+
+// renderImportBlocks returns Terraform import blocks for the resources.
+func renderImportBlocks(blocks []importBlock) string {
+	var sb strings.Builder
+
+	for i, block := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		// Escape template sequences in the quoted ID.
+		id := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(fmt.Sprintf("%q", block.ID.ValueString()))
+
+		fmt.Fprintf(&sb, "import {\n  to = %s\n  id = %s\n}\n", block.To.ValueString(), id)
+	}
+
+	return sb.String()
+}
+
+type dataSourceImportBlocksData struct {
+	ID              types.String `tfsdk:"id"`
+	ImportBlocks    types.String `tfsdk:"import_blocks"`
+	Region          types.String `tfsdk:"region"`
+	ResourceTypes   types.Set    `tfsdk:"resource_types"`
+	Resources       types.List   `tfsdk:"resources"`
+	ServicePackages types.Set    `tfsdk:"service_packages"`
+}
+
+type importBlock struct {
+	ID   types.String `tfsdk:"id"`
+	To   types.String `tfsdk:"to"`
+	Type types.String `tfsdk:"type"`
+}
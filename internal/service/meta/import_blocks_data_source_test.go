@@ -0,0 +1,115 @@
+package meta_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestImportBlockName(t *testing.T) {
+	t.Parallel()
+
+	names := make(map[string]bool)
+
+	for _, testCase := range []struct {
+		id   string
+		want string
+	}{
+		{id: "vpc-0123456789abcdef0", want: "vpc_0123456789abcdef0"},
+		{id: "arn:aws:iam::123456789012:policy/Example-Policy", want: "arn_aws_iam_123456789012_policy_example_policy"},
+		{id: "123-bucket", want: "r_123_bucket"},
+		{id: "example.bucket", want: "example_bucket"},
+		{id: "example-bucket", want: "example_bucket_2"},
+		{id: "Example_Bucket", want: "example_bucket_3"},
+	} {
+		if got := tfmeta.ImportBlockName(testCase.id, names); got != testCase.want {
+			t.Errorf("ImportBlockName(%q) = %q, want %q", testCase.id, got, testCase.want)
+		}
+	}
+}
+
+func TestAccMetaImportBlocksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_import_blocks.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportBlocksDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "resources.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resources.*", map[string]string{
+						"type": "aws_iam_role",
+						"id":   rName,
+					}),
+					resource.TestMatchResourceAttr(dataSourceName, "import_blocks", regexp.MustCompile(fmt.Sprintf(`import {\n  to = aws_iam_role\.[a-z0-9_]+\n  id = "%s"\n}`, rName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaImportBlocksDataSource_unknownServicePackage(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImportBlocksDataSourceConfig_servicePackages(`"nosuchservice"`),
+				ExpectError: regexp.MustCompile(`unknown service package: nosuchservice`),
+			},
+			{
+				Config:      testAccImportBlocksDataSourceConfig_servicePackages(`"meta"`),
+				ExpectError: regexp.MustCompile(`service package \(meta\) does not support import discovery`),
+			},
+		},
+	})
+}
+
+func testAccImportBlocksDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+data "aws_import_blocks" "test" {
+  service_packages = ["iam"]
+  resource_types   = ["aws_iam_role"]
+
+  depends_on = [aws_iam_role.test]
+}
+`, rName)
+}
+
+func testAccImportBlocksDataSourceConfig_servicePackages(servicePackages string) string {
+	return fmt.Sprintf(`
+data "aws_import_blocks" "test" {
+  service_packages = [%[1]s]
+}
+`, servicePackages)
+}
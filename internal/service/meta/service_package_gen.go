@@ -25,6 +25,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceIPRanges,
 		},
+		{
+			Factory: newDataSourceImportBlocks,
+		},
 		{
 			Factory: newDataSourcePartition,
 		},
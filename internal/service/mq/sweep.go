@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    sweepBrokers,
 	})
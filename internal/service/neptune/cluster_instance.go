@@ -11,10 +11,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -186,9 +186,9 @@ func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("identifier"); ok {
 		instanceID = v.(string)
 	} else if v, ok := d.GetOk("identifier_prefix"); ok {
-		instanceID = id.PrefixedUniqueId(v.(string))
+		instanceID = create.PrefixedUniqueId(ctx, v.(string))
 	} else {
-		instanceID = id.PrefixedUniqueId("tf-")
+		instanceID = create.PrefixedUniqueId(ctx, "tf-")
 	}
 
 	input := &neptune.CreateDBInstanceInput{
@@ -17,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
 		Name: "aws_neptune_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
 		Name: "aws_neptune_cluster_instance",
 		F:    sweepClusterInstances,
 		Dependencies: []string{
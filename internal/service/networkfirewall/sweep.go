@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -22,7 +22,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
 		Name: "aws_networkfirewall_firewall",
 		F:    sweepFirewalls,
 		Dependencies: []string{
@@ -30,12 +30,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -35,17 +35,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -53,17 +53,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -72,7 +72,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -80,7 +80,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -88,7 +88,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -96,7 +96,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -106,7 +105,7 @@ func (r *resourceAccessPolicy) Create(ctx context.Context, req resource.CreateRe
 	conn := r.Meta().OpenSearchServerlessClient(ctx)
 
 	in := &opensearchserverless.CreateAccessPolicyInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Name:        aws.String(plan.Name.ValueString()),
 		Policy:      aws.String(plan.Policy.ValueString()),
 		Type:        awstypes.AccessPolicyType(plan.Type.ValueString()),
@@ -163,7 +162,7 @@ func (r *resourceAccessPolicy) Update(ctx context.Context, req resource.UpdateRe
 	if !plan.Description.Equal(state.Description) ||
 		!plan.Policy.Equal(state.Policy) {
 		input := &opensearchserverless.UpdateAccessPolicyInput{
-			ClientToken:   aws.String(id.UniqueId()),
+			ClientToken:   aws.String(create.UniqueId(ctx)),
 			Name:          flex.StringFromFramework(ctx, plan.Name),
 			PolicyVersion: flex.StringFromFramework(ctx, state.PolicyVersion),
 			Type:          awstypes.AccessPolicyType(plan.Type.ValueString()),
@@ -199,7 +198,7 @@ func (r *resourceAccessPolicy) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	_, err := conn.DeleteAccessPolicy(ctx, &opensearchserverless.DeleteAccessPolicyInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Name:        aws.String(state.Name.ValueString()),
 		Type:        awstypes.AccessPolicyType(state.Type.ValueString()),
 	})
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -142,7 +141,7 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 	conn := r.Meta().OpenSearchServerlessClient(ctx)
 
 	in := &opensearchserverless.CreateCollectionInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Name:        aws.String(plan.Name.ValueString()),
 		Tags:        getTagsIn(ctx),
 	}
@@ -229,7 +228,7 @@ func (r *resourceCollection) Update(ctx context.Context, req resource.UpdateRequ
 
 	if !plan.Description.Equal(state.Description) {
 		input := &opensearchserverless.UpdateCollectionInput{
-			ClientToken: aws.String(id.UniqueId()),
+			ClientToken: aws.String(create.UniqueId(ctx)),
 			Id:          flex.StringFromFramework(ctx, plan.ID),
 			Description: flex.StringFromFramework(ctx, plan.Description),
 		}
@@ -264,7 +263,7 @@ func (r *resourceCollection) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	_, err := conn.DeleteCollection(ctx, &opensearchserverless.DeleteCollectionInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Id:          aws.String(state.ID.ValueString()),
 	})
 
@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -126,7 +125,7 @@ func (r *resourceSecurityConfig) Create(ctx context.Context, req resource.Create
 	conn := r.Meta().OpenSearchServerlessClient(ctx)
 
 	in := &opensearchserverless.CreateSecurityConfigInput{
-		ClientToken: aws.String(sdkid.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Name:        flex.StringFromFramework(ctx, plan.Name),
 		Type:        awstypes.SecurityConfigType(*flex.StringFromFramework(ctx, plan.Type)),
 		SamlOptions: expandSAMLOptions(ctx, plan.SamlOptions, &resp.Diagnostics),
@@ -191,7 +190,7 @@ func (r *resourceSecurityConfig) Update(ctx context.Context, req resource.Update
 	update := false
 
 	input := &opensearchserverless.UpdateSecurityConfigInput{
-		ClientToken:   aws.String(sdkid.UniqueId()),
+		ClientToken:   aws.String(create.UniqueId(ctx)),
 		ConfigVersion: flex.StringFromFramework(ctx, state.ConfigVersion),
 		Id:            flex.StringFromFramework(ctx, plan.ID),
 	}
@@ -231,7 +230,7 @@ func (r *resourceSecurityConfig) Delete(ctx context.Context, req resource.Delete
 	}
 
 	_, err := conn.DeleteSecurityConfig(ctx, &opensearchserverless.DeleteSecurityConfigInput{
-		ClientToken: aws.String(sdkid.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Id:          flex.StringFromFramework(ctx, state.ID),
 	})
 	if err != nil {
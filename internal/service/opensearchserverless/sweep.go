@@ -17,23 +17,23 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
 		Name: "aws_opensearchserverless_access_policy",
 		F:    sweepAccessPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
 		Name: "aws_opensearchserverless_collection",
 		F:    sweepCollections,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_config",
 		F:    sweepSecurityConfigs,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_policy",
 		F:    sweepSecurityPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_opensearchserverless_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 	})
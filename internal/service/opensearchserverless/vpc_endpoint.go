@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -120,7 +119,7 @@ func (r *resourceVpcEndpoint) Create(ctx context.Context, req resource.CreateReq
 	conn := r.Meta().OpenSearchServerlessClient(ctx)
 
 	in := &opensearchserverless.CreateVpcEndpointInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Name:        aws.String(plan.Name.ValueString()),
 		SubnetIds:   flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds),
 		VpcId:       aws.String(plan.VpcId.ValueString()),
@@ -198,7 +197,7 @@ func (r *resourceVpcEndpoint) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	input := &opensearchserverless.UpdateVpcEndpointInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Id:          aws.String(plan.ID.ValueString()),
 	}
 
@@ -278,7 +277,7 @@ func (r *resourceVpcEndpoint) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	_, err := conn.DeleteVpcEndpoint(ctx, &opensearchserverless.DeleteVpcEndpointInput{
-		ClientToken: aws.String(id.UniqueId()),
+		ClientToken: aws.String(create.UniqueId(ctx)),
 		Id:          aws.String(state.ID.ValueString()),
 	})
 
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
 		Name: "aws_opsworks_stack",
 		F:    sweepStacks,
 		Dependencies: []string{
@@ -28,18 +28,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
 		Name: "aws_opsworks_application",
 		F:    sweepApplication,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
 		Name: "aws_opsworks_instance",
 		F:    sweepInstance,
 	})
 
 	// This sweep all the custom, ecs, ganglia, etc. layers
-	resource.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
 		Name: "aws_opsworks_layer",
 		F:    sweepLayers,
 		Dependencies: []string{
@@ -48,7 +48,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
 		Name: "aws_opsworks_rds_db_instance",
 		F:    sweepRDSDBInstance,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
 		Name: "aws_opsworks_user_profile",
 		F:    sweepUserProfiles,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
 		Name: "aws_pipes_pipe",
 		F:    sweepPipes,
 	})
@@ -84,7 +84,7 @@ func ResourceLedger() *schema.Resource {
 func resourceLedgerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).QLDBConn(ctx)
 
-	name := create.Name(d.Get("name").(string), "tf")
+	name := create.Name(ctx, d.Get("name").(string), "tf")
 	input := &qldb.CreateLedgerInput{
 		DeletionProtection: aws.Bool(d.Get("deletion_protection").(bool)),
 		Name:               aws.String(name),
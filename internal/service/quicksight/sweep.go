@@ -16,27 +16,27 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
 		Name: "aws_quicksight_dashboard",
 		F:    sweepDashboards,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
 		Name: "aws_quicksight_data_set",
 		F:    sweepDataSets,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepDataSources,
 	})
-	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
 		Name: "aws_quicksight_folder",
 		F:    sweepFolders,
 	})
-	resource.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
 		Name: "aws_quicksight_template",
 		F:    sweepTemplates,
 	})
-	resource.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
 		Name: "aws_quicksight_user",
 		F:    sweepUsers,
 	})
@@ -11,10 +11,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -59,7 +59,7 @@ func resourcePrincipalAssociationCreate(ctx context.Context, d *schema.ResourceD
 	principal := d.Get("principal").(string)
 
 	request := &ram.AssociateResourceShareInput{
-		ClientToken:      aws.String(id.UniqueId()),
+		ClientToken:      aws.String(create.UniqueId(ctx)),
 		ResourceShareArn: aws.String(resourceShareArn),
 		Principals:       []*string{aws.String(principal)},
 	}
@@ -11,10 +11,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -53,7 +53,7 @@ func resourceResourceAssociationCreate(ctx context.Context, d *schema.ResourceDa
 	resourceShareARN := d.Get("resource_share_arn").(string)
 
 	input := &ram.AssociateResourceShareInput{
-		ClientToken:      aws.String(id.UniqueId()),
+		ClientToken:      aws.String(create.UniqueId(ctx)),
 		ResourceArns:     aws.StringSlice([]string{resourceARN}),
 		ResourceShareArn: aws.String(resourceShareARN),
 	}
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
 		Name: "aws_ram_resource_share",
 		F:    sweepResourceShares,
 	})
@@ -14,13 +14,14 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -33,7 +34,7 @@ const (
 	clusterTimeoutDelete                           = 2 * time.Minute
 )
 
-// @SDKResource("aws_rds_cluster", name="Cluster")
+// @SDKResource("aws_rds_cluster", name="Cluster", interceptors=clusterCreateConcurrencyLimitInterceptor)
 // @Tags(identifierAttribute="arn")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
@@ -531,6 +532,10 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
+func clusterCreateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.RDS, "CreateDBCluster", 0, interceptor.Create)
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
@@ -549,9 +554,9 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("cluster_identifier"); ok {
 		identifier = v.(string)
 	} else if v, ok := d.GetOk("cluster_identifier_prefix"); ok {
-		identifier = id.PrefixedUniqueId(v.(string))
+		identifier = create.PrefixedUniqueId(ctx, v.(string))
 	} else {
-		identifier = id.PrefixedUniqueId("tf-")
+		identifier = create.PrefixedUniqueId(ctx, "tf-")
 	}
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
@@ -10,10 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -233,9 +233,9 @@ func resourceClusterInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		identifier = v.(string)
 	} else {
 		if v, ok := d.GetOk("identifier_prefix"); ok {
-			identifier = id.PrefixedUniqueId(v.(string))
+			identifier = create.PrefixedUniqueId(ctx, v.(string))
 		} else {
-			identifier = id.PrefixedUniqueId("tf-")
+			identifier = create.PrefixedUniqueId(ctx, "tf-")
 		}
 	}
 	input := &rds.CreateDBInstanceInput{
@@ -0,0 +1,108 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportDiscoverers returns the functions that find the import IDs of existing resources.
+func (p *servicePackage) ImportDiscoverers(ctx context.Context) []*types.ServicePackageImportDiscoverer {
+	return []*types.ServicePackageImportDiscoverer{
+		{
+			Discover: discoverDBInstanceIdentifiers,
+			TypeName: "aws_db_instance",
+		},
+		{
+			Discover: discoverDBSubnetGroupNames,
+			TypeName: "aws_db_subnet_group",
+		},
+		{
+			Discover: discoverClusterIdentifiers,
+			TypeName: "aws_rds_cluster",
+		},
+	}
+}
+
+func discoverDBInstanceIdentifiers(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	var output []string
+
+	err := conn.DescribeDBInstancesPagesWithContext(ctx, &rds.DescribeDBInstancesInput{}, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBInstances {
+			// Instances in a cluster are managed by aws_rds_cluster_instance.
+			if v != nil && v.DBClusterIdentifier == nil {
+				output = append(output, aws.StringValue(v.DBInstanceIdentifier))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func discoverDBSubnetGroupNames(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	var output []string
+
+	err := conn.DescribeDBSubnetGroupsPagesWithContext(ctx, &rds.DescribeDBSubnetGroupsInput{}, func(page *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBSubnetGroups {
+			// The default DB subnet group can't be managed.
+			if v != nil && aws.StringValue(v.DBSubnetGroupName) != "default" {
+				output = append(output, aws.StringValue(v.DBSubnetGroupName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func discoverClusterIdentifiers(ctx context.Context, meta any) ([]string, error) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	var output []string
+
+	err := conn.DescribeDBClustersPagesWithContext(ctx, &rds.DescribeDBClustersInput{}, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBClusters {
+			if v != nil {
+				output = append(output, aws.StringValue(v.DBClusterIdentifier))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
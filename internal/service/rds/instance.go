@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -45,7 +46,7 @@ import (
 //    - can be updated
 //    - called "identifier" in the schema/state (previously was also "id")
 
-// @SDKResource("aws_db_instance", name="DB Instance")
+// @SDKResource("aws_db_instance", name="DB Instance", interceptors=instanceCreateConcurrencyLimitInterceptor)
 // @Tags(identifierAttribute="arn")
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
@@ -627,6 +628,10 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
+func instanceCreateConcurrencyLimitInterceptor() interceptor.SDKResourceItem {
+	return conns.ConcurrencyLimitInterceptor(names.RDS, "CreateDBInstance", 0, interceptor.Create)
+}
+
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
@@ -648,7 +653,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var requiresRebootDbInstance bool
 
 	// See discussion of IDs at the top of file - this is NOT d.Id()
-	identifier := create.Name(d.Get("identifier").(string), d.Get("identifier_prefix").(string))
+	identifier := create.Name(ctx, d.Get("identifier").(string), d.Get("identifier_prefix").(string))
 
 	var resourceID string // will be assigned depending on how it is created
 
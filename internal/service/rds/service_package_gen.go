@@ -11,6 +11,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -111,6 +112,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Interceptors: interceptor.SDKResourceItems{
+				instanceCreateConcurrencyLimitInterceptor(),
+			},
 		},
 		{
 			Factory:  ResourceInstanceAutomatedBackupsReplication,
@@ -191,6 +195,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			Interceptors: interceptor.SDKResourceItems{
+				clusterCreateConcurrencyLimitInterceptor(),
+			},
 		},
 		{
 			Factory:  ResourceClusterActivityStream,
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -42,17 +42,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -60,7 +60,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 		Dependencies: []string{
@@ -69,7 +69,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -77,12 +77,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -90,7 +90,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -98,7 +98,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackups,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_redshift_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -24,42 +24,42 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
 		Name: "aws_redshift_authentication_profile",
 		F:    sweepAuthenticationProfiles,
 	})
 
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
 
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
 		Name: "aws_redshiftserverless_namespace",
 		F:    sweepNamespaces,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
 		Name: "aws_redshiftserverless_workgroup",
 		F:    sweepWorkgroups,
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_snapshot", &resource.Sweeper{
 		Name: "aws_redshiftserverless_snapshot",
 		F:    sweepSnapshots,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_resourcegroups_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_resourcegroups_group", &resource.Sweeper{
 		Name: "aws_resourcegroups_group",
 		F:    sweepGroups,
 	})
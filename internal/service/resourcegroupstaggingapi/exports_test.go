@@ -0,0 +1,6 @@
+package resourcegroupstaggingapi
+
+// Exports for use in tests only.
+var (
+	FindResourceTagMappingsByARNs = findResourceTagMappingsByARNs
+)
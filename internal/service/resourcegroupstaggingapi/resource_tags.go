@@ -0,0 +1,422 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// TagResources and UntagResources accept at most 20 ARNs and 50 tags per call.
+	resourceARNsBatchSize = 20
+	tagsBatchSize         = 50
+	// GetResources accepts at most 100 ARNs per call.
+	getResourcesARNsBatchSize = 100
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_resource_tags", name="Resource Tags")
+// @IAMActions(create="tag:TagResources", read="tag:GetResources", update="tag:TagResources;tag:UntagResources", delete="tag:UntagResources")
+func ResourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceTagsCreate,
+		ReadWithoutTimeout:   resourceResourceTagsRead,
+		UpdateWithoutTimeout: resourceResourceTagsUpdate,
+		DeleteWithoutTimeout: resourceResourceTagsDelete,
+
+		CustomizeDiff: resourceResourceTagsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"managed_resource_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				ExactlyOneOf: []string{"resource_arns", "tag_filter"},
+			},
+			"resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arns"},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"resource_arns", "tag_filter"},
+			},
+			"tags": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	resourceARNs, err := resourceTagsResourceARNs(ctx, conn, d.Get("resource_arns").(*schema.Set), d.Get("tag_filter").([]interface{}), d.Get("resource_type_filters").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resource Tags: %s", err)
+	}
+
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+	failed := tagResources(ctx, conn, resourceARNs, tags)
+
+	d.SetId(create.UniqueId(ctx))
+	// Only resources that were successfully tagged are managed.
+	d.Set("managed_resource_arns", tfslices.Filter(resourceARNs, func(v string) bool {
+		_, ok := failed[v]
+		return !ok
+	}))
+
+	if err := failedResourcesError(failed); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("managed_resource_arns").(*schema.Set))
+	outputs, err := findResourceTagMappingsByARNs(ctx, conn, resourceARNs)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	resourceTags := make(map[string]tftags.KeyValueTags, len(outputs))
+	for _, v := range outputs {
+		resourceTags[aws.StringValue(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+	}
+
+	// Any managed resource without a managed tag or with a different tag value
+	// shows up as a difference in tags, so that the next apply re-tags every managed resource.
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{})).Map()
+	for _, resourceARN := range resourceARNs {
+		for key, value := range tags {
+			if v := resourceTags[resourceARN].KeyValue(key); v == nil {
+				log.Printf("[WARN] Resource Groups Tagging API Resource Tags (%s) tag (%s) not found on resource (%s)", d.Id(), key, resourceARN)
+				delete(tags, key)
+			} else if aws.StringValue(v) != value {
+				tags[key] = aws.StringValue(v)
+			}
+		}
+	}
+
+	d.Set("tags", tags)
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	resourceARNs, err := resourceTagsResourceARNs(ctx, conn, d.Get("resource_arns").(*schema.Set), d.Get("tag_filter").([]interface{}), d.Get("resource_type_filters").(*schema.Set))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	o, n := d.GetChange("tags")
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+	oldResourceARNs, _ := d.GetChange("managed_resource_arns")
+
+	failed := make(map[string]error)
+
+	// Resources no longer managed have all the previously managed tags removed.
+	removedResourceARNs := tfslices.Filter(flex.ExpandStringValueSet(oldResourceARNs.(*schema.Set)), func(v string) bool {
+		return !slices.Contains(resourceARNs, v)
+	})
+	for k, v := range untagResources(ctx, conn, removedResourceARNs, oldTags.Keys()) {
+		failed[k] = v
+	}
+
+	// Managed resources have removed tags removed and all tags applied, correcting any drift.
+	for k, v := range untagResources(ctx, conn, resourceARNs, oldTags.Removed(newTags).Keys()) {
+		failed[k] = v
+	}
+	for k, v := range tagResources(ctx, conn, resourceARNs, newTags) {
+		failed[k] = v
+	}
+
+	d.Set("managed_resource_arns", resourceARNs)
+
+	if err := failedResourcesError(failed); err != nil {
+		// Keep the previous tags in state so that the next apply is retried.
+		d.Set("tags", o)
+
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("managed_resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	log.Printf("[INFO] Deleting Resource Groups Tagging API Resource Tags: %s", d.Id())
+	failed := untagResources(ctx, conn, resourceARNs, tags.Keys())
+
+	if err := failedResourcesError(failed); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceResourceTagsCustomizeDiff plans the resources to be managed.
+// Resources matched by a tag filter are found at plan time, so that newly matching resources are tagged on the next apply.
+func resourceResourceTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("resource_arns") || !d.NewValueKnown("tag_filter") || !d.NewValueKnown("resource_type_filters") {
+		return d.SetNewComputed("managed_resource_arns")
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok {
+		if d.Id() == "" || d.HasChange("resource_arns") {
+			return d.SetNew("managed_resource_arns", v)
+		}
+
+		return nil
+	}
+
+	if d.Id() == "" {
+		return d.SetNewComputed("managed_resource_arns")
+	}
+
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+	resourceARNs, err := resourceTagsResourceARNs(ctx, conn, nil, d.Get("tag_filter").([]interface{}), d.Get("resource_type_filters").(*schema.Set))
+
+	if err != nil {
+		return err
+	}
+
+	if o := flex.ExpandStringValueSet(d.Get("managed_resource_arns").(*schema.Set)); !equalStringSets(o, resourceARNs) {
+		return d.SetNew("managed_resource_arns", resourceARNs)
+	}
+
+	return nil
+}
+
+// resourceTagsResourceARNs returns the configured resource ARNs, or the ARNs of the resources matching the tag filters.
+func resourceTagsResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs *schema.Set, tagFilters []interface{}, resourceTypeFilters *schema.Set) ([]string, error) {
+	if resourceARNs != nil && resourceARNs.Len() > 0 {
+		return flex.ExpandStringValueSet(resourceARNs), nil
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: expandTagFilters(tagFilters),
+	}
+
+	if resourceTypeFilters != nil && resourceTypeFilters.Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringSet(resourceTypeFilters)
+	}
+
+	outputs, err := findResourceTagMappings(ctx, conn, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("finding resources matching tag_filter: %w", err)
+	}
+
+	return tfslices.ApplyToAll(outputs, func(v *resourcegroupstaggingapi.ResourceTagMapping) string {
+		return aws.StringValue(v.ResourceARN)
+	}), nil
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, input *resourcegroupstaggingapi.GetResourcesInput) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	var output []*resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findResourceTagMappingsByARNs returns the tag mappings of the specified resources.
+// Resources that do not exist or have never been tagged are not returned.
+func findResourceTagMappingsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	var output []*resourcegroupstaggingapi.ResourceTagMapping
+
+	for _, resourceARNs := range tfslices.Chunks(resourceARNs, getResourcesARNsBatchSize) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(resourceARNs),
+		}
+
+		page, err := findResourceTagMappings(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page...)
+	}
+
+	return output, nil
+}
+
+// tagResources applies the tags to the resources in batches.
+// The returned map contains the error for each resource that could not be tagged.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string, tags tftags.KeyValueTags) map[string]error {
+	failed := make(map[string]error)
+
+	for _, resourceARNs := range tfslices.Chunks(resourceARNs, resourceARNsBatchSize) {
+		for _, tags := range tags.IgnoreAWS().Chunks(tagsBatchSize) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: aws.StringSlice(resourceARNs),
+				Tags:            aws.StringMap(tags.Map()),
+			}
+
+			output, err := conn.TagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				for _, resourceARN := range resourceARNs {
+					failed[resourceARN] = err
+				}
+
+				continue
+			}
+
+			for resourceARN, apiObject := range output.FailedResourcesMap {
+				failed[resourceARN] = failureInfoError(apiObject)
+			}
+		}
+	}
+
+	return failed
+}
+
+// untagResources removes the tag keys from the resources in batches.
+// The returned map contains the error for each resource that could not be untagged.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string, tagKeys []string) map[string]error {
+	failed := make(map[string]error)
+
+	if len(tagKeys) == 0 {
+		return failed
+	}
+
+	for _, resourceARNs := range tfslices.Chunks(resourceARNs, resourceARNsBatchSize) {
+		for _, tagKeys := range tfslices.Chunks(tagKeys, tagsBatchSize) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice(resourceARNs),
+				TagKeys:         aws.StringSlice(tagKeys),
+			}
+
+			output, err := conn.UntagResourcesWithContext(ctx, input)
+
+			if err != nil {
+				for _, resourceARN := range resourceARNs {
+					failed[resourceARN] = err
+				}
+
+				continue
+			}
+
+			for resourceARN, apiObject := range output.FailedResourcesMap {
+				failed[resourceARN] = failureInfoError(apiObject)
+			}
+		}
+	}
+
+	return failed
+}
+
+func failureInfoError(apiObject *resourcegroupstaggingapi.FailureInfo) error {
+	if apiObject == nil {
+		return errors.New("unknown failure")
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+}
+
+// failedResourcesError returns an error listing, in ARN order, the resources that could not be tagged or untagged.
+func failedResourcesError(failed map[string]error) error {
+	resourceARNs := make([]string, 0, len(failed))
+	for resourceARN := range failed {
+		resourceARNs = append(resourceARNs, resourceARN)
+	}
+	slices.Sort(resourceARNs)
+
+	errs := make([]error, 0, len(resourceARNs))
+	for _, resourceARN := range resourceARNs {
+		errs = append(errs, fmt.Errorf("%s: %w", resourceARN, failed[resourceARN]))
+	}
+
+	return errors.Join(errs...)
+}
+
+func equalStringSets(s1, s2 []string) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	for _, v := range s1 {
+		if !slices.Contains(s2, v) {
+			return false
+		}
+	}
+
+	return true
+}
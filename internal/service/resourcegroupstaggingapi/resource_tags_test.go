@@ -0,0 +1,205 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_resourceARNs(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_resourceARNs(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_tagFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_tagFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "managed_resource_arns.*", "aws_vpc.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "managed_resource_arns.*", "aws_vpc.test.1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostCenter", "shared"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroupstaggingapi_resource_tags" {
+				continue
+			}
+
+			mappings, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, testAccResourceTagsManagedARNs(rs))
+
+			if err != nil {
+				return err
+			}
+
+			for _, mapping := range mappings {
+				for _, tag := range mapping.Tags {
+					if _, ok := rs.Primary.Attributes["tags."+aws.StringValue(tag.Key)]; ok {
+						return fmt.Errorf("Resource Groups Tagging API Resource Tags %s tag (%s) still exists on resource (%s)", rs.Primary.ID, aws.StringValue(tag.Key), aws.StringValue(mapping.ResourceARN))
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn(ctx)
+
+		resourceARNs := testAccResourceTagsManagedARNs(rs)
+		mappings, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, resourceARNs)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(mappings), len(resourceARNs); got != want {
+			return fmt.Errorf("Resource Groups Tagging API Resource Tags %s found %d resources, want %d", rs.Primary.ID, got, want)
+		}
+
+		for _, mapping := range mappings {
+			tags := tfresourcegroupstaggingapi.KeyValueTags(ctx, mapping.Tags).Map()
+
+			for k, value := range rs.Primary.Attributes {
+				key, ok := strings.CutPrefix(k, "tags.")
+				if !ok || key == "%" {
+					continue
+				}
+
+				if got := tags[key]; got != value {
+					return fmt.Errorf("Resource Groups Tagging API Resource Tags %s resource (%s) tag (%s) = %q, want %q", rs.Primary.ID, aws.StringValue(mapping.ResourceARN), key, got, value)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceTagsManagedARNs(rs *terraform.ResourceState) []string {
+	var resourceARNs []string
+
+	for key, value := range rs.Primary.Attributes {
+		if strings.HasPrefix(key, "managed_resource_arns.") && key != "managed_resource_arns.#" {
+			resourceARNs = append(resourceARNs, value)
+		}
+	}
+
+	return resourceARNs
+}
+
+func testAccResourceTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  # Tags applied by aws_resourcegroupstaggingapi_resource_tags.
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccResourceTagsConfig_resourceARNs(rName, tagKey, tagValue string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_resource_tags" "test" {
+  resource_arns = aws_vpc.test[*].arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey, tagValue))
+}
+
+func testAccResourceTagsConfig_tagFilter(rName string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), `
+resource "aws_resourcegroupstaggingapi_resource_tags" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test[0].tags["Name"]]
+  }
+
+  tags = {
+    CostCenter = "shared"
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`)
+}
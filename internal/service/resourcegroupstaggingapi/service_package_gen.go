@@ -33,7 +33,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceResourceTags,
+			TypeName: "aws_resourcegroupstaggingapi_resource_tags",
+			Name:     "Resource Tags",
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"tag:TagResources"},
+				Read:   []string{"tag:GetResources"},
+				Update: []string{"tag:TagResources", "tag:UntagResources"},
+				Delete: []string{"tag:UntagResources"},
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
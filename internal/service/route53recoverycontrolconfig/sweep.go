@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_control_panel",
 		F:    sweepControlPanels,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_routing_control",
 		F:    sweepRoutingControls,
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_safety_rule",
 		F:    sweepSafetyRules,
 	})
@@ -8,10 +8,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -63,7 +63,7 @@ func resourceFirewallRuleGroupCreate(ctx context.Context, d *schema.ResourceData
 
 	name := d.Get("name").(string)
 	input := &route53resolver.CreateFirewallRuleGroupInput{
-		CreatorRequestId: aws.String(id.PrefixedUniqueId("tf-r53-resolver-firewall-rule-group-")),
+		CreatorRequestId: aws.String(create.PrefixedUniqueId(ctx, "tf-r53-resolver-firewall-rule-group-")),
 		Name:             aws.String(name),
 		Tags:             getTagsIn(ctx),
 	}
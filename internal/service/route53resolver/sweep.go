@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_dnssec_config",
 		F:    sweepDNSSECConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -28,12 +28,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    sweepFirewallConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_domain_list",
 		F:    sweepFirewallDomainLists,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group_association",
 		F:    sweepFirewallRuleGroupAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group",
 		F:    sweepFirewallRuleGroups,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule",
 		F:    sweepFirewallRules,
 		Dependencies: []string{
@@ -63,12 +63,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogAssociationsConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    sweepQueryLogsConfig,
 		Dependencies: []string{
@@ -76,12 +76,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    sweepRuleAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    sweepRules,
 		Dependencies: []string{
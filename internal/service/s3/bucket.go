@@ -696,7 +696,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
-	bucket := create.Name(d.Get("bucket").(string), d.Get("bucket_prefix").(string))
+	bucket := create.Name(ctx, d.Get("bucket").(string), d.Get("bucket_prefix").(string))
 
 	awsRegion := meta.(*conns.AWSClient).Region
 
@@ -1718,7 +1718,7 @@ func resourceBucketInternalLifecycleUpdate(ctx context.Context, conn *s3.S3, d *
 		if val, ok := r["id"].(string); ok && val != "" {
 			rule.ID = aws.String(val)
 		} else {
-			rule.ID = aws.String(id.PrefixedUniqueId("tf-s3-lifecycle-"))
+			rule.ID = aws.String(create.PrefixedUniqueId(ctx, "tf-s3-lifecycle-"))
 		}
 
 		// Enabled
@@ -0,0 +1,59 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportDiscoverers returns the functions that find the import IDs of existing resources.
+func (p *servicePackage) ImportDiscoverers(ctx context.Context) []*types.ServicePackageImportDiscoverer {
+	return []*types.ServicePackageImportDiscoverer{
+		{
+			Discover: discoverBucketNames,
+			TypeName: "aws_s3_bucket",
+		},
+	}
+}
+
+// discoverBucketNames returns the names of the buckets in the provider's Region.
+func discoverBucketNames(ctx context.Context, meta any) ([]string, error) {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3Conn(ctx)
+
+	output, err := conn.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, v := range output.Buckets {
+		if v == nil {
+			continue
+		}
+
+		name := aws.StringValue(v.Name)
+		region, err := s3manager.GetBucketRegionWithClient(ctx, conn, name, func(r *request.Request) {
+			// By default, GetBucketRegion forces virtual host addressing.
+			r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("getting S3 Bucket (%s) Region: %w", name, err)
+		}
+
+		if region == client.Region {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
@@ -23,12 +23,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -24,17 +24,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -500,11 +499,11 @@ func resourceEndpointConfigurationCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	createOpts := &sagemaker.CreateEndpointConfigInput{
 		EndpointConfigName: aws.String(name),
-		ProductionVariants: expandProductionVariants(d.Get("production_variants").([]interface{})),
+		ProductionVariants: expandProductionVariants(ctx, d.Get("production_variants").([]interface{})),
 		Tags:               getTagsIn(ctx),
 	}
 
@@ -513,7 +512,7 @@ func resourceEndpointConfigurationCreate(ctx context.Context, d *schema.Resource
 	}
 
 	if v, ok := d.GetOk("shadow_production_variants"); ok && len(v.([]interface{})) > 0 {
-		createOpts.ShadowProductionVariants = expandProductionVariants(v.([]interface{}))
+		createOpts.ShadowProductionVariants = expandProductionVariants(ctx, v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("data_capture_config"); ok {
@@ -604,7 +603,7 @@ func resourceEndpointConfigurationDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
-func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionVariant {
+func expandProductionVariants(ctx context.Context, configured []interface{}) []*sagemaker.ProductionVariant {
 	containers := make([]*sagemaker.ProductionVariant, 0, len(configured))
 
 	for _, lRaw := range configured {
@@ -637,7 +636,7 @@ func expandProductionVariants(configured []interface{}) []*sagemaker.ProductionV
 		if v, ok := data["variant_name"].(string); ok && v != "" {
 			l.VariantName = aws.String(v)
 		} else {
-			l.VariantName = aws.String(id.UniqueId())
+			l.VariantName = aws.String(create.UniqueId(ctx))
 		}
 
 		if v, ok := data["initial_variant_weight"]; ok {
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
 		Name: "aws_scheduler_schedule_group",
 		F:    sweepScheduleGroups,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
 		Name: "aws_scheduler_schedule",
 		F:    sweepSchedules,
 	})
@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret_policy",
 		F:    sweepSecretPolicies,
 	})
 
-	resource.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret",
 		F:    sweepSecrets,
 	})
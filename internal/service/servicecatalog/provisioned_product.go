@@ -12,11 +12,11 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -280,7 +280,7 @@ func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
 
 	input := &servicecatalog.ProvisionProductInput{
-		ProvisionToken:         aws.String(id.UniqueId()),
+		ProvisionToken:         aws.String(create.UniqueId(ctx)),
 		ProvisionedProductName: aws.String(d.Get("name").(string)),
 		Tags:                   getTagsIn(ctx),
 	}
@@ -486,7 +486,7 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
 
 	input := &servicecatalog.UpdateProvisionedProductInput{
-		UpdateToken:          aws.String(id.UniqueId()),
+		UpdateToken:          aws.String(create.UniqueId(ctx)),
 		ProvisionedProductId: aws.String(d.Id()),
 	}
 
@@ -563,7 +563,7 @@ func resourceProvisionedProductDelete(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
 
 	input := &servicecatalog.TerminateProvisionedProductInput{
-		TerminateToken:       aws.String(id.UniqueId()),
+		TerminateToken:       aws.String(create.UniqueId(ctx)),
 		ProvisionedProductId: aws.String(d.Id()),
 	}
 
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_http_namespace",
 		F:    sweepHTTPNamespaces,
 		Dependencies: []string{
@@ -22,7 +22,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_private_dns_namespace",
 		F:    sweepPrivateDNSNamespaces,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
 		Name: "aws_service_discovery_public_dns_namespace",
 		F:    sweepPublicDNSNamespaces,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
 		Name: "aws_service_discovery_service",
 		F:    sweepServices,
 	})
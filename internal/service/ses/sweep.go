@@ -16,22 +16,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
 		Name: "aws_sesv2_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
 		Name: "aws_sesv2_contact_list",
 		F:    sweepContactLists,
 	})
@@ -134,7 +134,7 @@ func resourceSigningProfileCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Creating Signer signing profile")
 
-	profileName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	profileName := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	profileName = strings.Replace(profileName, "-", "_", -1)
 
 	signingProfileInput := &signer.PutSigningProfileInput{
@@ -85,8 +85,10 @@ func resourceSigningProfilePermissionCreate(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
-	defer conns.GlobalMutexKV.Unlock(profileName)
+	if err := conns.GlobalKeyedLock.Lock(ctx, profileName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Signer Signing Profile Permission: %s", err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, profileName)
 
 	listProfilePermissionsInput := &signer.ListProfilePermissionsInput{
 		ProfileName: aws.String(profileName),
@@ -104,7 +106,7 @@ func resourceSigningProfilePermissionCreate(ctx context.Context, d *schema.Resou
 		revisionId = aws.StringValue(getProfilePermissionsOutput.RevisionId)
 	}
 
-	statementId := create.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
+	statementId := create.Name(ctx, d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
 
 	addProfilePermissionInput := &signer.AddProfilePermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
@@ -227,8 +229,10 @@ func resourceSigningProfilePermissionDelete(ctx context.Context, d *schema.Resou
 
 	profileName := d.Get("profile_name").(string)
 
-	conns.GlobalMutexKV.Lock(profileName)
-	defer conns.GlobalMutexKV.Unlock(profileName)
+	if err := conns.GlobalKeyedLock.Lock(ctx, profileName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Signer Signing Profile Permission (%s): %s", d.Id(), err)
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, profileName)
 
 	listProfilePermissionsInput := &signer.ListProfilePermissionsInput{
 		ProfileName: aws.String(profileName),
@@ -49,6 +49,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"sns:CreateTopic", "sns:SetTopicAttributes", "sns:TagResource"},
+				Read:   []string{"sns:GetTopicAttributes", "sns:ListTagsForResource"},
+				Update: []string{"sns:SetTopicAttributes", "sns:TagResource", "sns:UntagResource"},
+				Delete: []string{"sns:DeleteTopic"},
+			},
 		},
 		{
 			Factory:  ResourceTopicDataProtectionPolicy,
@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
 
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
 		Name: "aws_sns_topic",
 		F:    sweepTopics,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
@@ -175,6 +175,7 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="sqs:CreateQueue;sqs:TagQueue", read="sqs:GetQueueAttributes;sqs:ListQueueTags", update="sqs:SetQueueAttributes;sqs:TagQueue;sqs:UntagQueue", delete="sqs:DeleteQueue;sqs:GetQueueAttributes")
 func ResourceQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueueCreate,
@@ -201,9 +202,9 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	var name string
 	fifoQueue := d.Get("fifo_queue").(bool)
 	if fifoQueue {
-		name = create.NameWithSuffix(d.Get("name").(string), d.Get("name_prefix").(string), FIFOQueueNameSuffix)
+		name = create.NameWithSuffix(ctx, d.Get("name").(string), d.Get("name_prefix").(string), FIFOQueueNameSuffix)
 	} else {
-		name = create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+		name = create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	}
 
 	input := &sqs.CreateQueueInput{
@@ -363,7 +364,7 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+func resourceQueueCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	fifoQueue := diff.Get("fifo_queue").(bool)
 	contentBasedDeduplication := diff.Get("content_based_deduplication").(bool)
 
@@ -373,9 +374,9 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		var name string
 
 		if fifoQueue {
-			name = create.NameWithSuffix(diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOQueueNameSuffix)
+			name = create.NameWithSuffix(ctx, diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOQueueNameSuffix)
 		} else {
-			name = create.Name(diff.Get("name").(string), diff.Get("name_prefix").(string))
+			name = create.Name(ctx, diff.Get("name").(string), diff.Get("name_prefix").(string))
 		}
 
 		var re *regexp.Regexp
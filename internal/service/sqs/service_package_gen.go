@@ -45,6 +45,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"sqs:CreateQueue", "sqs:TagQueue"},
+				Read:   []string{"sqs:GetQueueAttributes", "sqs:ListQueueTags"},
+				Update: []string{"sqs:SetQueueAttributes", "sqs:TagQueue", "sqs:UntagQueue"},
+				Delete: []string{"sqs:DeleteQueue", "sqs:GetQueueAttributes"},
+			},
 		},
 		{
 			Factory:  ResourceQueuePolicy,
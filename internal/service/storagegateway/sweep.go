@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
 		Name: "aws_storagegateway_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -22,12 +22,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
 		Name: "aws_storagegateway_tape_pool",
 		F:    sweepTapePools,
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
 		Name: "aws_storagegateway_file_system_association",
 		F:    sweepFileSystemAssociations,
 	})
@@ -280,7 +280,7 @@ func resourceCanaryCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Tags:               getTagsIn(ctx),
 	}
 
-	if code, err := expandCanaryCode(d); err != nil {
+	if code, err := expandCanaryCode(ctx, d); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Synthetics Canary (%s): %s", name, err)
 	} else {
 		input.Code = code
@@ -441,7 +441,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if d.HasChanges("handler", "zip_file", "s3_bucket", "s3_key", "s3_version") {
-			if code, err := expandCanaryCode(d); err != nil {
+			if code, err := expandCanaryCode(ctx, d); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
 			} else {
 				input.Code = code
@@ -558,14 +558,16 @@ func resourceCanaryDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
-func expandCanaryCode(d *schema.ResourceData) (*synthetics.CanaryCodeInput, error) {
+func expandCanaryCode(ctx context.Context, d *schema.ResourceData) (*synthetics.CanaryCodeInput, error) {
 	codeConfig := &synthetics.CanaryCodeInput{
 		Handler: aws.String(d.Get("handler").(string)),
 	}
 
 	if v, ok := d.GetOk("zip_file"); ok {
-		conns.GlobalMutexKV.Lock(canaryMutex)
-		defer conns.GlobalMutexKV.Unlock(canaryMutex)
+		if err := conns.GlobalKeyedLock.Lock(ctx, canaryMutex, 0); err != nil {
+			return nil, err
+		}
+		defer conns.GlobalKeyedLock.Unlock(ctx, canaryMutex)
 		file, err := loadFileContent(v.(string))
 		if err != nil {
 			return nil, fmt.Errorf("unable to load %q: %w", v.(string), err)
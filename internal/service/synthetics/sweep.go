@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
 		Name: "aws_synthetics_canary",
 		F:    sweepCanaries,
 		Dependencies: []string{
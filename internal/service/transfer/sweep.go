@@ -14,12 +14,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
 
-	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
 		Name: "aws_transfer_workflow",
 		F:    sweepWorkflows,
 		Dependencies: []string{
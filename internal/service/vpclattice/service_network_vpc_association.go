@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -87,7 +86,7 @@ func resourceServiceNetworkVPCAssociationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
 
 	in := &vpclattice.CreateServiceNetworkVpcAssociationInput{
-		ClientToken:              aws.String(id.UniqueId()),
+		ClientToken:              aws.String(create.UniqueId(ctx)),
 		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
 		VpcIdentifier:            aws.String(d.Get("vpc_identifier").(string)),
 		Tags:                     getTagsIn(ctx),
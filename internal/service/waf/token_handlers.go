@@ -19,8 +19,10 @@ type WafRetryer struct {
 type withTokenFunc func(token *string) (interface{}, error)
 
 func (t *WafRetryer) RetryWithToken(ctx context.Context, f withTokenFunc) (interface{}, error) {
-	conns.GlobalMutexKV.Lock("WafRetryer")
-	defer conns.GlobalMutexKV.Unlock("WafRetryer")
+	if err := conns.GlobalKeyedLock.Lock(ctx, "WafRetryer", 0); err != nil {
+		return nil, err
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, "WafRetryer")
 
 	var out interface{}
 	var tokenOut *waf.GetChangeTokenOutput
@@ -21,8 +21,10 @@ type WafRegionalRetryer struct {
 type withRegionalTokenFunc func(token *string) (interface{}, error)
 
 func (t *WafRegionalRetryer) RetryWithToken(ctx context.Context, f withRegionalTokenFunc) (interface{}, error) {
-	conns.GlobalMutexKV.Lock(t.Region)
-	defer conns.GlobalMutexKV.Unlock(t.Region)
+	if err := conns.GlobalKeyedLock.Lock(ctx, t.Region, 0); err != nil {
+		return nil, err
+	}
+	defer conns.GlobalKeyedLock.Unlock(ctx, t.Region)
 
 	var out interface{}
 	var tokenOut *waf.GetChangeTokenOutput
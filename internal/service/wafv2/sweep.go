@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -41,7 +41,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
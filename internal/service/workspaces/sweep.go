@@ -15,18 +15,18 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
 		Name:         "aws_workspaces_directory",
 		F:            sweepDirectories,
 		Dependencies: []string{"aws_workspaces_workspace", "aws_workspaces_ip_group"},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
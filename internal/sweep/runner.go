@@ -0,0 +1,419 @@
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// DefaultParallelism is the default maximum number of sweepers run at once.
+const DefaultParallelism = 10
+
+var flagSweepParallelism = flag.Int("sweep-parallelism", DefaultParallelism, "Maximum number of Sweepers to run at once")
+
+var (
+	// sweepers is the registry of all sweepers, keyed by name.
+	sweepers = make(map[string]*resource.Sweeper)
+	// sweeperNames maps the entry points of registered sweeper functions to sweeper names.
+	sweeperNames = make(map[uintptr]string)
+)
+
+// AddTestSweepers registers the specified sweeper.
+// Sweeper names must be unique.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+	if s.F != nil {
+		sweeperNames[reflect.ValueOf(s.F).Pointer()] = name
+	}
+}
+
+// TestMain runs the registered sweepers when the -sweep flag is set, otherwise tests are run as normal.
+// The -sweep and -sweep-run flags are those of the Terraform Plugin Testing framework's TestMain.
+// Failing sweepers don't stop other sweepers from being run, so -sweep-allow-failures is ignored.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		report, err := Run(context.Background(), strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String(), *flagSweepParallelism)
+
+		if err != nil {
+			log.Printf("[ERROR] Error running Sweepers: %s", err)
+			os.Exit(1)
+		}
+
+		if err := report.Write(os.Stdout); err != nil {
+			log.Printf("[ERROR] Error writing Sweeper report: %s", err)
+		}
+
+		if report.HasFailures() {
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	resource.TestMain(m)
+}
+
+// SweeperReport is the outcome of running a single sweeper in all regions.
+type SweeperReport struct {
+	Name    string
+	Deleted int              // Number of resources deleted
+	Failed  int              // Number of regions in which the sweeper failed
+	Skipped int              // Number of regions in which the sweeper was skipped because a dependency failed
+	Errors  map[string]error // Sweeper errors, keyed by region
+}
+
+// Report is the outcome of a sweeper run.
+type Report struct {
+	lock     sync.Mutex
+	sweepers map[string]*SweeperReport
+}
+
+func newReport(names []string) *Report {
+	r := &Report{
+		sweepers: make(map[string]*SweeperReport, len(names)),
+	}
+
+	for _, name := range names {
+		r.sweepers[name] = &SweeperReport{
+			Name:   name,
+			Errors: make(map[string]error),
+		}
+	}
+
+	return r
+}
+
+// Sweepers returns the report for each sweeper, sorted by name.
+func (r *Report) Sweepers() []SweeperReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reports := make([]SweeperReport, 0, len(r.sweepers))
+	for _, v := range r.sweepers {
+		reports = append(reports, *v)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+
+	return reports
+}
+
+// HasFailures returns whether any sweeper failed or was skipped.
+func (r *Report) HasFailures() bool {
+	for _, v := range r.Sweepers() {
+		if v.Failed > 0 || v.Skipped > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes a human-readable summary of the report.
+func (r *Report) Write(w io.Writer) error {
+	reports := r.Sweepers()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SWEEPER\tDELETED\tFAILED\tSKIPPED")
+	for _, v := range reports {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\n", v.Name, v.Deleted, v.Failed, v.Skipped)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, v := range reports {
+		regions := make([]string, 0, len(v.Errors))
+		for region := range v.Errors {
+			regions = append(regions, region)
+		}
+		sort.Strings(regions)
+
+		for _, region := range regions {
+			if _, err := fmt.Fprintf(w, "\n%s (%s): %s\n", v.Name, region, v.Errors[region]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Report) update(name string, f func(*SweeperReport)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if v, ok := r.sweepers[name]; ok {
+		f(v)
+	}
+}
+
+// currentReport is the report of the sweeper run in progress, if any.
+var currentReport struct {
+	sync.Mutex
+	report *Report
+}
+
+// recordDeleted attributes successfully deleted resources to the sweeper whose function is on the call stack.
+// Sweeper functions aren't passed a Context, so the call stack is the only way to identify the calling sweeper.
+func recordDeleted(n int) {
+	currentReport.Lock()
+	report := currentReport.report
+	currentReport.Unlock()
+
+	if report == nil || n == 0 {
+		return
+	}
+
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+
+		if name, ok := sweeperNames[frame.Entry]; ok {
+			report.update(name, func(v *SweeperReport) {
+				v.Deleted += n
+			})
+			return
+		}
+
+		if !more {
+			return
+		}
+	}
+}
+
+// Run runs the registered sweepers in the specified regions.
+// If filter is not empty then only sweepers whose names contain one of the comma-separated values, and their dependencies, are run.
+// Sweepers are run once all the sweepers they depend on have been run, with independent sweepers run in parallel.
+// At most parallelism sweepers are run at once across all regions.
+// A sweeper that fails doesn't stop other sweepers from being run, but any sweepers depending on it are skipped in that region.
+func Run(ctx context.Context, regions []string, filter string, parallelism int) (*Report, error) {
+	g, err := sweeperGraph(filterSweepers(filter))
+
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := newReport(order)
+	currentReport.Lock()
+	currentReport.report = report
+	currentReport.Unlock()
+	defer func() {
+		currentReport.Lock()
+		currentReport.report = nil
+		currentReport.Unlock()
+	}()
+
+	if parallelism <= 0 {
+		parallelism = DefaultParallelism
+	}
+	semaphore := make(tfsync.Semaphore, parallelism)
+
+	var wg sync.WaitGroup
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runRegion(ctx, region, g, order, semaphore, report)
+		}()
+	}
+	wg.Wait()
+
+	return report, nil
+}
+
+// runRegion runs the sweepers in the dependency graph in the specified region.
+func runRegion(ctx context.Context, region string, g *depgraph.Graph, order []string, semaphore tfsync.Semaphore, report *Report) {
+	type result struct {
+		name string
+		err  error
+	}
+
+	done := make(map[string]error, len(order)) // Sweeper outcomes. A nil value means success.
+	running := make(map[string]bool)
+	results := make(chan result)
+
+	for len(done) < len(order) {
+		for _, name := range order {
+			if _, ok := done[name]; ok || running[name] {
+				continue
+			}
+
+			ready, failedDependency := true, ""
+			dependencies, _ := g.DirectDependenciesOf(name)
+			for _, dependency := range dependencies {
+				err, ok := done[dependency]
+				if !ok {
+					ready = false
+					break
+				}
+				if err != nil && failedDependency == "" {
+					failedDependency = dependency
+				}
+			}
+
+			if !ready {
+				continue
+			}
+
+			if failedDependency != "" {
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): dependency (%s) failed", name, region, failedDependency)
+				err := fmt.Errorf("skipped, dependency (%s) failed", failedDependency)
+				done[name] = err
+				report.update(name, func(v *SweeperReport) {
+					v.Skipped++
+				})
+				continue
+			}
+
+			running[name] = true
+			go func(name string) {
+				results <- result{name: name, err: runSweeper(ctx, region, name, semaphore)}
+			}(name)
+		}
+
+		if len(running) == 0 {
+			// All remaining sweepers were skipped.
+			continue
+		}
+
+		r := <-results
+		delete(running, r.name)
+		done[r.name] = r.err
+
+		if r.err != nil {
+			report.update(r.name, func(v *SweeperReport) {
+				v.Failed++
+				v.Errors[region] = r.err
+			})
+		}
+	}
+}
+
+// runSweeper runs the specified sweeper in the specified region, waiting for capacity.
+func runSweeper(ctx context.Context, region, name string, semaphore tfsync.Semaphore) error {
+	if err := semaphore.Acquire(ctx); err != nil {
+		return err
+	}
+	defer semaphore.Notify()
+
+	s := sweepers[name]
+
+	if s.F == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+	start := time.Now()
+
+	err := s.F(region)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+	}
+
+	return err
+}
+
+// filterSweepers returns the names of the sweepers whose names contain one of the comma-separated values in filter, and their dependencies.
+// All sweepers are returned if filter is empty.
+func filterSweepers(filter string) []string {
+	var names []string
+
+	for name := range sweepers {
+		if filter == "" {
+			names = append(names, name)
+			continue
+		}
+
+		for _, v := range strings.Split(strings.ToLower(filter), ",") {
+			if v != "" && strings.Contains(strings.ToLower(name), v) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers and, transitively, their dependencies.
+func sweeperGraph(names []string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	var add func(string) error
+	add = func(name string) error {
+		if g.HasNode(name) {
+			return nil
+		}
+
+		s, ok := sweepers[name]
+
+		if !ok {
+			return fmt.Errorf("sweeper (%s) not found", name)
+		}
+
+		g.AddNode(name)
+
+		for _, dependency := range s.Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := add(dependency); err != nil {
+				return err
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,201 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct{}
+
+func (testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+// testSweeperRuns records the order in which test sweepers are run.
+var testSweeperRuns struct {
+	sync.Mutex
+	names []string
+}
+
+func recordTestSweeperRun(name, region string) {
+	testSweeperRuns.Lock()
+	defer testSweeperRuns.Unlock()
+
+	testSweeperRuns.names = append(testSweeperRuns.names, name+"/"+region)
+}
+
+func testSweepVPCs(region string) error {
+	recordTestSweeperRun("vpc", region)
+	return nil
+}
+
+func testSweepSubnets(region string) error {
+	recordTestSweeperRun("subnet", region)
+	return SweepOrchestratorWithContext(context.Background(), []Sweepable{testSweepable{}, testSweepable{}})
+}
+
+func testSweepInstances(region string) error {
+	recordTestSweeperRun("instance", region)
+	return SweepOrchestratorWithContext(context.Background(), []Sweepable{testSweepable{}})
+}
+
+func testSweepRoles(region string) error {
+	recordTestSweeperRun("role", region)
+	if region == "us-east-1" { //lintignore:AWSAT003
+		return errors.New("test failure")
+	}
+	return nil
+}
+
+func testSweepPolicies(region string) error {
+	recordTestSweeperRun("policy", region)
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	// Not parallel as the sweeper registry is modified.
+	savedSweepers, savedSweeperNames := sweepers, sweeperNames
+	t.Cleanup(func() {
+		sweepers, sweeperNames = savedSweepers, savedSweeperNames
+	})
+	sweepers, sweeperNames = make(map[string]*resource.Sweeper), make(map[uintptr]string)
+
+	AddTestSweepers("test_vpc", &resource.Sweeper{
+		Name:         "test_vpc",
+		Dependencies: []string{"test_subnet"},
+		F:            testSweepVPCs,
+	})
+	AddTestSweepers("test_subnet", &resource.Sweeper{
+		Name:         "test_subnet",
+		Dependencies: []string{"test_instance", "test_not_registered"},
+		F:            testSweepSubnets,
+	})
+	AddTestSweepers("test_instance", &resource.Sweeper{
+		Name: "test_instance",
+		F:    testSweepInstances,
+	})
+	AddTestSweepers("test_policy", &resource.Sweeper{
+		Name:         "test_policy",
+		Dependencies: []string{"test_role"},
+		F:            testSweepPolicies,
+	})
+	AddTestSweepers("test_role", &resource.Sweeper{
+		Name: "test_role",
+		F:    testSweepRoles,
+	})
+
+	regions := []string{"us-west-2", "us-east-1"} //lintignore:AWSAT003
+	report, err := Run(context.Background(), regions, "", 2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Dependencies are run before their dependents.
+	testSweeperRuns.Lock()
+	runs := testSweeperRuns.names
+	testSweeperRuns.Unlock()
+
+	index := func(run string) int {
+		for i, v := range runs {
+			if v == run {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, region := range regions {
+		instance, subnet, vpc := index("instance/"+region), index("subnet/"+region), index("vpc/"+region)
+		if instance < 0 || subnet < 0 || vpc < 0 || !(instance < subnet && subnet < vpc) {
+			t.Errorf("unexpected sweeper order in %s: %v", region, runs)
+		}
+	}
+
+	// Dependents of a failed sweeper are skipped in that region only.
+	if index("policy/us-west-2") < 0 { //lintignore:AWSAT003
+		t.Errorf("policy sweeper not run in us-west-2: %v", runs)
+	}
+	if index("policy/us-east-1") >= 0 { //lintignore:AWSAT003
+		t.Errorf("policy sweeper run in us-east-1: %v", runs)
+	}
+
+	expected := []SweeperReport{
+		{Name: "test_instance", Deleted: 2},
+		{Name: "test_policy", Skipped: 1},
+		{Name: "test_role", Failed: 1},
+		{Name: "test_subnet", Deleted: 4},
+		{Name: "test_vpc"},
+	}
+	got := report.Sweepers()
+
+	if len(got) != len(expected) {
+		t.Fatalf("len(Sweepers) = %d, want %d", len(got), len(expected))
+	}
+	for i, want := range expected {
+		v := got[i]
+		if v.Name != want.Name || v.Deleted != want.Deleted || v.Failed != want.Failed || v.Skipped != want.Skipped {
+			t.Errorf("Sweepers[%d] = %+v, want %+v", i, v, want)
+		}
+	}
+
+	if !report.HasFailures() {
+		t.Error("expected failures")
+	}
+
+	var sb strings.Builder
+	if err := report.Write(&sb); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), "test_role (us-east-1): test failure") { //lintignore:AWSAT003
+		t.Errorf("unexpected report:\n%s", sb.String())
+	}
+}
+
+func TestRunDependencyCycle(t *testing.T) {
+	// Not parallel as the sweeper registry is modified.
+	savedSweepers, savedSweeperNames := sweepers, sweeperNames
+	t.Cleanup(func() {
+		sweepers, sweeperNames = savedSweepers, savedSweeperNames
+	})
+	sweepers, sweeperNames = make(map[string]*resource.Sweeper), make(map[uintptr]string)
+
+	AddTestSweepers("test_a", &resource.Sweeper{
+		Name:         "test_a",
+		Dependencies: []string{"test_b"},
+	})
+	AddTestSweepers("test_b", &resource.Sweeper{
+		Name:         "test_b",
+		Dependencies: []string{"test_a"},
+	})
+
+	if _, err := Run(context.Background(), []string{"us-west-2"}, "", 1); err == nil { //lintignore:AWSAT003
+		t.Fatal("expected dependency cycle error")
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	// Not parallel as the sweeper registry is modified.
+	savedSweepers, savedSweeperNames := sweepers, sweeperNames
+	t.Cleanup(func() {
+		sweepers, sweeperNames = savedSweepers, savedSweeperNames
+	})
+	sweepers, sweeperNames = make(map[string]*resource.Sweeper), make(map[uintptr]string)
+
+	for _, name := range []string{"aws_vpc", "aws_subnet", "aws_iam_role"} {
+		AddTestSweepers(name, &resource.Sweeper{Name: name})
+	}
+
+	if got, want := strings.Join(filterSweepers(""), ","), "aws_iam_role,aws_subnet,aws_vpc"; got != want {
+		t.Errorf("filterSweepers(\"\") = %s, want %s", got, want)
+	}
+	if got, want := strings.Join(filterSweepers("VPC,role"), ","), "aws_iam_role,aws_vpc"; got != want {
+		t.Errorf("filterSweepers(\"VPC,role\") = %s, want %s", got, want)
+	}
+}
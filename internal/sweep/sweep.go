@@ -7,9 +7,11 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -65,24 +67,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -103,16 +109,27 @@ type Sweepable interface {
 
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	var g multierror.Group
+	var deleted int64
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+			if err == nil {
+				atomic.AddInt64(&deleted, 1)
+			}
+
+			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err := g.Wait().ErrorOrNil()
+
+	recordDeleted(int(deleted))
+
+	return err
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LimiterKey returns the Limiter key for the specified service package and AWS API operation, e.g. `eks/CreateCluster`.
+func LimiterKey(servicePackageName, operation string) string {
+	return servicePackageName + "/" + operation
+}
+
+// Limiter limits the number of concurrent executions of operations, by key.
+// Each key's limit is either configured when the Limiter is created or defaults to the limit specified when the key is first acquired.
+// A limit of zero or less means that executions are not limited.
+type Limiter struct {
+	lock       sync.Mutex
+	limits     map[string]int
+	semaphores map[string]Semaphore
+}
+
+// NewLimiter returns a new Limiter with the specified configured limits, which override any default limits.
+func NewLimiter(limits map[string]int) *Limiter {
+	return &Limiter{
+		limits:     limits,
+		semaphores: make(map[string]Semaphore),
+	}
+}
+
+// Acquire waits until an execution of the operation with the specified key can start.
+// An error is returned if the Context is done before the execution can start.
+// The caller is responsible for calling Release for the same key if, and only if, no error is returned.
+func (l *Limiter) Acquire(ctx context.Context, key string, defaultLimit int) error {
+	s := l.semaphore(key, defaultLimit)
+
+	if s == nil {
+		return nil
+	}
+
+	ctx = tflog.SetField(ctx, "tf_aws.limiter.key", key)
+	ctx = tflog.SetField(ctx, "tf_aws.limiter.limit", cap(s))
+
+	// Fast path.
+	select {
+	case s <- struct{}{}:
+		return nil
+	default:
+	}
+
+	tflog.Info(ctx, "Concurrency limit reached, waiting")
+	start := time.Now()
+
+	if err := s.Acquire(ctx); err != nil {
+		return fmt.Errorf("waiting for concurrency limit (%s): %w", key, err)
+	}
+
+	tflog.Debug(ctx, "Concurrency limit acquired", map[string]any{
+		"tf_aws.limiter.wait": time.Since(start).String(),
+	})
+
+	return nil
+}
+
+// Release ends an execution of the operation with the specified key.
+func (l *Limiter) Release(key string) {
+	l.lock.Lock()
+	s, ok := l.semaphores[key]
+	l.lock.Unlock()
+
+	if ok && s != nil {
+		s.Notify()
+	}
+}
+
+// semaphore returns the specified key's semaphore, creating it if necessary.
+// nil is returned if the key's executions are not limited.
+func (l *Limiter) semaphore(key string, defaultLimit int) Semaphore {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if s, ok := l.semaphores[key]; ok {
+		return s
+	}
+
+	limit := defaultLimit
+	if v, ok := l.limits[key]; ok {
+		limit = v
+	}
+
+	var s Semaphore
+	if limit > 0 {
+		s = make(Semaphore, limit)
+	}
+	l.semaphores[key] = s
+
+	return s
+}
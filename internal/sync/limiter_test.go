@@ -0,0 +1,98 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterDefaultLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := NewLimiter(nil)
+	key := LimiterKey("eks", "CreateCluster")
+
+	for i := 0; i < 2; i++ {
+		if err := l.Acquire(ctx, key, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		l.Acquire(ctx, key, 2) //nolint:errcheck // Can't fail without a deadline.
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Third execution was able to start. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	l.Release(key)
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(time.Second):
+		t.Fatal("Third execution blocked after release. This shouldn't happen.")
+	}
+}
+
+func TestLimiterConfiguredLimit(t *testing.T) {
+	t.Parallel()
+
+	key := LimiterKey("rds", "CreateDBCluster")
+	l := NewLimiter(map[string]int{key: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Acquire(ctx, key, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Acquire(ctx, key, 5); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := LimiterKey("ds", "CreateDirectory")
+	l := NewLimiter(map[string]int{key: 0})
+
+	for i := 0; i < 10; i++ {
+		if err := l.Acquire(ctx, key, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		l.Release(key)
+	}
+
+	// Releasing a key that was never acquired is a no-op.
+	l.Release(LimiterKey("ds", "DeleteDirectory"))
+}
+
+func TestLimiterDifferentKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	l := NewLimiter(nil)
+
+	if err := l.Acquire(ctx, LimiterKey("eks", "CreateCluster"), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Acquire(ctx, LimiterKey("eks", "DeleteCluster"), 1); err != nil {
+		t.Fatalf("Execution of a different key blocked. This shouldn't happen: %s", err)
+	}
+}
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -12,7 +13,6 @@ import (
 type Semaphore chan struct{}
 
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 	limit := defaultLimit
 	x := os.Getenv(envvar)
@@ -26,14 +26,23 @@ func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 	return make(Semaphore, limit)
 }
 
+// Acquire waits for a semaphore before continuing.
+// An error is returned if the Context is done before the semaphore is acquired.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -44,7 +53,6 @@ func (s Semaphore) Notify() {
 }
 
 // TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func TestAccPreCheckSyncronize(t *testing.T, semaphore Semaphore, resource string) {
 	if cap(semaphore) == 0 {
 		t.Skipf("concurrency for %s testing set to 0", resource)
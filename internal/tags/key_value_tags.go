@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -29,13 +30,70 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags   KeyValueTags
+	Scopes []DefaultScope
+}
+
+// DefaultScope contains tags to default across the resources in a scope.
+// Empty Services or ResourceTypes include all service packages or resource types.
+type DefaultScope struct {
+	Tags                 KeyValueTags
+	Services             []string // Service package names, e.g. "ec2"
+	ExcludeServices      []string
+	ResourceTypes        []string // Resource type names, e.g. "aws_instance"
+	ExcludeResourceTypes []string
+}
+
+// Includes returns whether a resource of the specified type in the specified service package is in the scope.
+func (ds DefaultScope) Includes(servicePackageName, typeName string) bool {
+	if len(ds.Services) > 0 && !slices.Contains(ds.Services, servicePackageName) {
+		return false
+	}
+
+	if slices.Contains(ds.ExcludeServices, servicePackageName) {
+		return false
+	}
+
+	if len(ds.ResourceTypes) > 0 && !slices.Contains(ds.ResourceTypes, typeName) {
+		return false
+	}
+
+	return !slices.Contains(ds.ExcludeResourceTypes, typeName)
+}
+
+// ForResource returns the default tags for a resource of the specified type in the specified service package.
+// The Tags of each scope that includes the resource are merged, in order, on to the DefaultConfig's Tags.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Scopes) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, scope := range dc.Scopes {
+		if scope.Includes(servicePackageName, typeName) {
+			tags = tags.Merge(scope.Tags)
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeySuffixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	KeyValues   []IgnoreKeyValue
+}
+
+// IgnoreKeyValue matches tags whose key and value both match regular expressions.
+type IgnoreKeyValue struct {
+	Key   *regexp.Regexp
+	Value *regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -103,6 +161,9 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreKeyValues(config.KeyValues)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -155,6 +216,78 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, re := range ignoreTagRegexes {
+			if re.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyValues returns tags not matching any of the key and value rules.
+func (tags KeyValueTags) IgnoreKeyValues(ignoreKeyValues []IgnoreKeyValue) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, kv := range ignoreKeyValues {
+			if kv.Key.MatchString(k) && kv.Value.MatchString(v.ValueString()) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
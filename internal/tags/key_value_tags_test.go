@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -9,6 +10,124 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"CostCenter": "shared",
+			"Owner":      "platform",
+		}),
+		Scopes: []DefaultScope{
+			{
+				Tags:     New(ctx, map[string]string{"CostCenter": "compute"}),
+				Services: []string{"ec2", "autoscaling"},
+			},
+			{
+				Tags:                 New(ctx, map[string]string{"Backup": "daily"}),
+				Services:             []string{"ec2"},
+				ExcludeResourceTypes: []string{"aws_launch_template"},
+			},
+			{
+				Tags:          New(ctx, map[string]string{"CostCenter": "streaming"}),
+				ResourceTypes: []string{"aws_kinesis_stream"},
+			},
+			{
+				Tags:            New(ctx, map[string]string{"Compliance": "pci"}),
+				ExcludeServices: []string{"kinesis"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			defaultConfig:      nil,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+		},
+		{
+			name: "no scopes",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "platform"}),
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:               "service scopes",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Backup":     "daily",
+				"Compliance": "pci",
+				"CostCenter": "compute",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "excluded resource type",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_launch_template",
+			want: map[string]string{
+				"Compliance": "pci",
+				"CostCenter": "compute",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "resource type scope and excluded service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "kinesis",
+			typeName:           "aws_kinesis_stream",
+			want: map[string]string{
+				"CostCenter": "streaming",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "no matching scopes",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "kinesis",
+			typeName:           "aws_kinesis_firehose_delivery_stream",
+			want: map[string]string{
+				"CostCenter": "shared",
+				"Owner":      "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	t.Parallel()
 
@@ -736,6 +855,180 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreSuffixes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagSuffixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			ignoreTagSuffixes: New(ctx, []string{
+				"-scanned",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"key1-scanned": "value1",
+				"key2":         "value2",
+				"scanned-key3": "value3",
+			}),
+			ignoreTagSuffixes: New(ctx, []string{
+				"-scanned",
+			}),
+			want: map[string]string{
+				"key2":         "value2",
+				"scanned-key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagSuffixes: New(ctx, []string{
+				"-scanned",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreSuffixes(testCase.ignoreTagSuffixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^kubernetes\.io/cluster/`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"Scanner-20230101":           "clean",
+				"Name":                       "test",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^kubernetes\.io/cluster/`),
+				regexp.MustCompile(`^Scanner-[0-9]{8}$`),
+			},
+			want: map[string]string{
+				"Name": "test",
+			},
+		},
+		{
+			name: "no regexes",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreKeyValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name            string
+		tags            KeyValueTags
+		ignoreKeyValues []IgnoreKeyValue
+		want            map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			ignoreKeyValues: []IgnoreKeyValue{
+				{Key: regexp.MustCompile(`^ManagedBy$`), Value: regexp.MustCompile(`^backup-`)},
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "key and value must both match",
+			tags: New(ctx, map[string]string{
+				"ManagedBy": "backup-vault",
+				"Owner":     "backup-team",
+			}),
+			ignoreKeyValues: []IgnoreKeyValue{
+				{Key: regexp.MustCompile(`^ManagedBy$`), Value: regexp.MustCompile(`^backup-`)},
+			},
+			want: map[string]string{
+				"Owner": "backup-team",
+			},
+		},
+		{
+			name: "value not matching",
+			tags: New(ctx, map[string]string{
+				"ManagedBy": "terraform",
+			}),
+			ignoreKeyValues: []IgnoreKeyValue{
+				{Key: regexp.MustCompile(`^ManagedBy$`), Value: regexp.MustCompile(`^backup-`)},
+			},
+			want: map[string]string{
+				"ManagedBy": "terraform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreKeyValues(testCase.ignoreKeyValues)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreSystem(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,133 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	PolicyEnforcementError   = "error"
+	PolicyEnforcementWarning = "warning"
+)
+
+const (
+	PolicyKeyCaseCamel  = "camel"
+	PolicyKeyCaseKebab  = "kebab"
+	PolicyKeyCaseLower  = "lower"
+	PolicyKeyCasePascal = "pascal"
+	PolicyKeyCaseSnake  = "snake"
+	PolicyKeyCaseUpper  = "upper"
+)
+
+func PolicyEnforcement_Values() []string {
+	return []string{
+		PolicyEnforcementError,
+		PolicyEnforcementWarning,
+	}
+}
+
+func PolicyKeyCase_Values() []string {
+	return []string{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseKebab,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseSnake,
+		PolicyKeyCaseUpper,
+	}
+}
+
+var policyKeyCaseRegexps = map[string]*regexp.Regexp{
+	PolicyKeyCaseCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	PolicyKeyCaseKebab:  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	PolicyKeyCaseLower:  regexp.MustCompile(`^[^A-Z]*$`),
+	PolicyKeyCasePascal: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	PolicyKeyCaseSnake:  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	PolicyKeyCaseUpper:  regexp.MustCompile(`^[^a-z]*$`),
+}
+
+// PolicyConfig contains rules that resource tags must comply with.
+type PolicyConfig struct {
+	Enforcement string // Either "error" (the default) or "warning"
+	KeyCase     string // If set, all tag keys must be in this case
+	Rules       []PolicyRule
+}
+
+// PolicyRule contains the rules for a single tag key.
+type PolicyRule struct {
+	Key           string
+	Required      bool
+	AllowedValues []string
+	ValuePattern  *regexp.Regexp
+}
+
+// PolicyError is returned when resource tags don't comply with a tag policy.
+type PolicyError struct {
+	Violations []string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("tags do not comply with the provider tag_policy:\n\t- %s", strings.Join(e.Violations, "\n\t- "))
+}
+
+// Warn returns whether tag policy violations are reported as warnings rather than errors.
+func (pc *PolicyConfig) Warn() bool {
+	return pc != nil && pc.Enforcement == PolicyEnforcementWarning
+}
+
+// Evaluate returns a *PolicyError describing every way in which the given tags,
+// typically a resource's merged `tags_all`, don't comply with the policy.
+func (pc *PolicyConfig) Evaluate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	if re, ok := policyKeyCaseRegexps[pc.KeyCase]; ok {
+		keys := tags.Keys()
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			// System tags can't be renamed.
+			if strings.HasPrefix(k, awsTagKeyPrefix) {
+				continue
+			}
+
+			if !re.MatchString(k) {
+				violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, pc.KeyCase))
+			}
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		v, ok := tags[rule.Key]
+
+		if !ok {
+			if rule.Required {
+				violations = append(violations, fmt.Sprintf("required tag %q is missing", rule.Key))
+			}
+			continue
+		}
+
+		value := v.ValueString()
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of %q", rule.Key, value, rule.AllowedValues))
+		}
+
+		if rule.ValuePattern != nil && !rule.ValuePattern.MatchString(value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", rule.Key, value, rule.ValuePattern))
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &PolicyError{Violations: violations}
+}
@@ -0,0 +1,111 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigEvaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name       string
+		config     *PolicyConfig
+		tags       KeyValueTags
+		violations []string
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			tags:   New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "compliant",
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCasePascal,
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true, ValuePattern: regexp.MustCompile(`^[0-9]{4}$`)},
+					{Key: "Environment", Required: true, AllowedValues: []string{"dev", "prod"}},
+					{Key: "Owner"},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+			}),
+		},
+		{
+			name: "required key missing",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true},
+					{Key: "Owner"},
+				},
+			},
+			tags:       New(ctx, map[string]string{"Environment": "prod"}),
+			violations: []string{`required tag "CostCenter" is missing`},
+		},
+		{
+			name: "value not allowed",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "Environment", AllowedValues: []string{"dev", "prod"}},
+				},
+			},
+			tags:       New(ctx, map[string]string{"Environment": "test"}),
+			violations: []string{`tag "Environment" value "test" is not one of ["dev" "prod"]`},
+		},
+		{
+			name: "value does not match pattern",
+			config: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", ValuePattern: regexp.MustCompile(`^[0-9]{4}$`)},
+				},
+			},
+			tags:       New(ctx, map[string]string{"CostCenter": "abc"}),
+			violations: []string{`tag "CostCenter" value "abc" does not match "^[0-9]{4}$"`},
+		},
+		{
+			name: "key case",
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseKebab,
+			},
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "stack",
+				"cost-center":                   "1234",
+				"costCenter":                    "1234",
+				"Owner":                         "me",
+			}),
+			violations: []string{
+				`tag key "Owner" is not kebab case`,
+				`tag key "costCenter" is not kebab case`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.config.Evaluate(testCase.tags)
+
+			var violations []string
+			var policyErr *PolicyError
+			if errors.As(err, &policyErr) {
+				violations = policyErr.Violations
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(violations, testCase.violations); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
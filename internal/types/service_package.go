@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/interceptor"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -14,6 +15,35 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIAMActions represents the IAM actions needed by each of a resource's CRUD operations.
+type ServicePackageResourceIAMActions struct {
+	Create []string
+	Read   []string
+	Update []string
+	Delete []string
+}
+
+// Actions returns the IAM actions needed by the specified CRUD operation ("create", "read", "update" or "delete").
+// Create and Update read the resource after changing it, so the Read actions are included.
+func (a *ServicePackageResourceIAMActions) Actions(operation string) []string {
+	var actions []string
+
+	switch operation {
+	case "create":
+		actions = append(actions, a.Create...)
+		actions = append(actions, a.Read...)
+	case "read":
+		actions = append(actions, a.Read...)
+	case "update":
+		actions = append(actions, a.Update...)
+		actions = append(actions, a.Read...)
+	case "delete":
+		actions = append(actions, a.Delete...)
+	}
+
+	return actions
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -25,9 +55,11 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory      func(context.Context) (resource.ResourceWithConfigure, error)
+	Name         string
+	Tags         *ServicePackageResourceTags
+	Interceptors interceptor.FrameworkResourceItems // Resource-specific interceptors
+	IAMActions   *ServicePackageResourceIAMActions  // IAM actions needed, if declared
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -39,11 +71,20 @@ type ServicePackageSDKDataSource struct {
 	Tags     *ServicePackageResourceTags
 }
 
+// ServicePackageImportDiscoverer represents a function that finds the import IDs
+// of the existing resources of a single resource type implemented by a service package.
+type ServicePackageImportDiscoverer struct {
+	Discover func(context.Context, any) ([]string, error)
+	TypeName string
+}
+
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory      func() *schema.Resource
+	TypeName     string
+	Name         string
+	Tags         *ServicePackageResourceTags
+	Interceptors interceptor.SDKResourceItems      // Resource-specific interceptors
+	IAMActions   *ServicePackageResourceIAMActions // IAM actions needed, if declared
 }
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServicePackageResourceIAMActions(t *testing.T) {
+	t.Parallel()
+
+	actions := &ServicePackageResourceIAMActions{
+		Create: []string{"sqs:CreateQueue"},
+		Read:   []string{"sqs:GetQueueAttributes"},
+		Update: []string{"sqs:SetQueueAttributes"},
+		Delete: []string{"sqs:DeleteQueue"},
+	}
+
+	for operation, want := range map[string][]string{
+		"create":  {"sqs:CreateQueue", "sqs:GetQueueAttributes"},
+		"read":    {"sqs:GetQueueAttributes"},
+		"update":  {"sqs:SetQueueAttributes", "sqs:GetQueueAttributes"},
+		"delete":  {"sqs:DeleteQueue"},
+		"unknown": nil,
+	} {
+		if diff := cmp.Diff(actions.Actions(operation), want); diff != "" {
+			t.Errorf("Actions(%q): unexpected diff (+wanted, -got): %s", operation, diff)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// volatileFields are request parameters whose values legitimately differ between recording and replaying,
+// typically idempotency tokens. They are ignored when matching requests.
+// Names are compared case-insensitively.
+var volatileFields = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"CreationToken",
+	"IdempotencyToken",
+}
+
+func isVolatileField(name string) bool {
+	// Query protocol list and map members are flattened, e.g. "Foo.1.ClientToken".
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	for _, v := range volatileFields {
+		if strings.EqualFold(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matcher returns a go-vcr request matcher for AWS API interactions.
+//
+// Requests match if their methods and URLs (ignoring query parameter order) are equal and their bodies are
+// semantically equal for the AWS protocol in use:
+//
+//   - query and EC2 protocols (form-encoded bodies), ignoring parameter order
+//   - JSON and REST-JSON protocols, ignoring object member order
+//   - REST-XML protocol, ignoring element order
+//
+// Volatile fields such as idempotency tokens are ignored and credentials and account IDs are redacted
+// from both the live request and the recorded interaction before comparison.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func Matcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !urlsMatch(Redact(r.URL.String()), Redact(i.URL)) {
+			return false
+		}
+
+		var body string
+		if r.Body != nil {
+			var b bytes.Buffer
+			if _, err := b.ReadFrom(r.Body); err != nil {
+				tflog.Debug(ctx, "Failed to read request body", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			r.Body = io.NopCloser(&b)
+			body = b.String()
+		}
+
+		return BodiesMatch(ctx, r.Header.Get("Content-Type"), Redact(body), Redact(i.Body))
+	}
+}
+
+// urlsMatch returns whether or not two URLs are equal, ignoring query parameter order and volatile query parameters.
+func urlsMatch(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	urlA, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	urlB, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	if urlA.Scheme != urlB.Scheme || urlA.Host != urlB.Host || urlA.Path != urlB.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeValues(urlA.Query()), normalizeValues(urlB.Query()))
+}
+
+// BodiesMatch returns whether or not two request bodies are semantically equal for the specified content type.
+func BodiesMatch(ctx context.Context, contentType, a, b string) bool {
+	// If bodies match identically, we are done.
+	if a == b {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		return formBodiesMatch(ctx, a, b)
+
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return jsonBodiesMatch(ctx, a, b)
+
+	case "application/xml", "text/xml":
+		return xmlBodiesMatch(ctx, a, b)
+	}
+
+	return false
+}
+
+func formBodiesMatch(ctx context.Context, a, b string) bool {
+	valuesA, err := url.ParseQuery(a)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request form", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	valuesB, err := url.ParseQuery(b)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette form", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeValues(valuesA), normalizeValues(valuesB))
+}
+
+// normalizeValues removes volatile fields from URL values.
+func normalizeValues(values url.Values) url.Values {
+	normalized := make(url.Values, len(values))
+
+	for k, v := range values {
+		if isVolatileField(k) {
+			continue
+		}
+		normalized[k] = v
+	}
+
+	return normalized
+}
+
+func jsonBodiesMatch(ctx context.Context, a, b string) bool {
+	var jsonA, jsonB any
+
+	if err := json.Unmarshal([]byte(a), &jsonA); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &jsonB); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeJSON(jsonA), normalizeJSON(jsonB))
+}
+
+// normalizeJSON removes volatile fields from a decoded JSON value.
+func normalizeJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		normalized := make(map[string]any, len(v))
+		for k, v := range v {
+			if isVolatileField(k) {
+				continue
+			}
+			normalized[k] = normalizeJSON(v)
+		}
+		return normalized
+
+	case []any:
+		normalized := make([]any, len(v))
+		for i, v := range v {
+			normalized[i] = normalizeJSON(v)
+		}
+		return normalized
+
+	default:
+		return v
+	}
+}
+
+func xmlBodiesMatch(ctx context.Context, a, b string) bool {
+	xmlA, err := parseXML(a)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request XML", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	xmlB, err := parseXML(b)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette XML", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	return xmlA.canonical() == xmlB.canonical()
+}
+
+// xmlNode is a generic XML element.
+type xmlNode struct {
+	name     string
+	text     string
+	children []*xmlNode
+}
+
+func parseXML(s string) (*xmlNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: token.Name.Local}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			parent.text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return root, nil
+}
+
+// canonical returns a canonical string representation of the element, ignoring
+// child element order and volatile elements.
+func (n *xmlNode) canonical() string {
+	children := make([]string, 0, len(n.children))
+	for _, child := range n.children {
+		if isVolatileField(child.name) {
+			continue
+		}
+		children = append(children, child.canonical())
+	}
+	sort.Strings(children)
+
+	return "<" + n.name + ">" + n.text + strings.Join(children, "") + "</" + n.name + ">"
+}
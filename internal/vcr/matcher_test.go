@@ -0,0 +1,151 @@
+package vcr
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		ContentType string
+		A           string
+		B           string
+		Expected    bool
+	}{
+		{
+			TestName: "identical",
+			A:        "abc",
+			B:        "abc",
+			Expected: true,
+		},
+		{
+			TestName: "unknown content type",
+			A:        "abc",
+			B:        "def",
+			Expected: false,
+		},
+		{
+			TestName:    "query reordered",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			A:           "Action=CreateQueue&QueueName=test&Version=2012-11-05",
+			B:           "Version=2012-11-05&QueueName=test&Action=CreateQueue",
+			Expected:    true,
+		},
+		{
+			TestName:    "query different",
+			ContentType: "application/x-www-form-urlencoded",
+			A:           "Action=CreateQueue&QueueName=test1",
+			B:           "Action=CreateQueue&QueueName=test2",
+			Expected:    false,
+		},
+		{
+			TestName:    "query volatile",
+			ContentType: "application/x-www-form-urlencoded",
+			A:           "Action=RunInstances&ClientToken=terraform-1&TagSpecification.1.ClientToken=a",
+			B:           "Action=RunInstances&ClientToken=terraform-2",
+			Expected:    true,
+		},
+		{
+			TestName:    "JSON reordered",
+			ContentType: "application/x-amz-json-1.0",
+			A:           `{"TableName":"test","BillingMode":"PAY_PER_REQUEST"}`,
+			B:           `{"BillingMode":"PAY_PER_REQUEST","TableName":"test"}`,
+			Expected:    true,
+		},
+		{
+			TestName:    "JSON volatile",
+			ContentType: "application/x-amz-json-1.1",
+			A:           `{"Name":"test","ClientRequestToken":"a","Nested":{"clientToken":"b"}}`,
+			B:           `{"Name":"test","ClientRequestToken":"c","Nested":{"clientToken":"d"}}`,
+			Expected:    true,
+		},
+		{
+			TestName:    "JSON different",
+			ContentType: "application/json",
+			A:           `{"Name":"test1"}`,
+			B:           `{"Name":"test2"}`,
+			Expected:    false,
+		},
+		{
+			TestName:    "XML reordered",
+			ContentType: "application/xml",
+			A:           `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>a</CallerReference><HostedZoneConfig><Comment>x</Comment></HostedZoneConfig></CreateHostedZoneRequest>`,
+			B:           `<CreateHostedZoneRequest><HostedZoneConfig><Comment>x</Comment></HostedZoneConfig><CallerReference>b</CallerReference><Name>example.com</Name></CreateHostedZoneRequest>`,
+			Expected:    true,
+		},
+		{
+			TestName:    "XML different",
+			ContentType: "text/xml",
+			A:           `<Request><Name>a</Name></Request>`,
+			B:           `<Request><Name>b</Name></Request>`,
+			Expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := BodiesMatch(context.Background(), testCase.ContentType, testCase.A, testCase.B), testCase.Expected; got != want {
+				t.Errorf("BodiesMatch = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		URL      string
+		Body     string
+		Recorded cassette.Request
+		Expected bool
+	}{
+		{
+			TestName: "method differs",
+			URL:      "https://sqs.us-west-2.amazonaws.com/",
+			Recorded: cassette.Request{Method: http.MethodGet, URL: "https://sqs.us-west-2.amazonaws.com/"},
+			Expected: false,
+		},
+		{
+			TestName: "query parameters reordered",
+			URL:      "https://s3.us-west-2.amazonaws.com/bucket?versioning=&x-id=GetBucketVersioning",
+			Recorded: cassette.Request{Method: http.MethodPost, URL: "https://s3.us-west-2.amazonaws.com/bucket?x-id=GetBucketVersioning&versioning="},
+			Expected: true,
+		},
+		{
+			TestName: "account ID redacted",
+			URL:      "https://sqs.us-west-2.amazonaws.com/999988887777/test",
+			Body:     "Action=GetQueueAttributes",
+			Recorded: cassette.Request{Method: http.MethodPost, URL: "https://sqs.us-west-2.amazonaws.com/123456789012/test", Body: "Action=GetQueueAttributes"},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequest(http.MethodPost, testCase.URL, strings.NewReader(testCase.Body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			if got, want := Matcher(context.Background())(r, testCase.Recorded), testCase.Expected; got != want {
+				t.Errorf("Matcher = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package vcr implements provider-level recording and replaying of AWS API traffic.
+//
+// When the TF_AWS_VCR_MODE and TF_AWS_VCR_PATH environment variables are set, every AWS API call made
+// by the provider's HTTP client during a normal `terraform plan` or `terraform apply` is recorded into
+// (RECORDING) or replayed from (REPLAYING) a single go-vcr cassette in the specified directory.
+// Credentials and AWS account IDs are redacted before interactions are written to the cassette.
+package vcr
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	EnvVarMode = "TF_AWS_VCR_MODE"
+	EnvVarPath = "TF_AWS_VCR_PATH"
+)
+
+const (
+	ModeRecording = "RECORDING"
+	ModeReplaying = "REPLAYING"
+)
+
+// CassetteName is the name (without extension) of the cassette written to TF_AWS_VCR_PATH.
+const CassetteName = "terraform-provider-aws"
+
+// IsEnabled returns whether or not provider-level recording or replaying is enabled.
+func IsEnabled() bool {
+	return os.Getenv(EnvVarMode) != "" && os.Getenv(EnvVarPath) != ""
+}
+
+// IsReplaying returns whether or not provider-level replaying is enabled.
+func IsReplaying() bool {
+	return IsEnabled() && os.Getenv(EnvVarMode) == ModeReplaying
+}
+
+var (
+	sharedClient     *http.Client
+	sharedClientErr  error
+	sharedClientOnce sync.Once
+)
+
+// HTTPClient returns the process-wide http.Client that records or replays AWS API traffic.
+// All provider configurations in a provider process share the same cassette.
+func HTTPClient(ctx context.Context) (*http.Client, error) {
+	sharedClientOnce.Do(func() {
+		sharedClient, sharedClientErr = newHTTPClient(ctx, os.Getenv(EnvVarMode), os.Getenv(EnvVarPath))
+	})
+
+	return sharedClient, sharedClientErr
+}
+
+func newHTTPClient(ctx context.Context, mode, path string) (*http.Client, error) {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	rt, err := newRoundTripper(ctx, mode, filepath.Join(path, CassetteName), httpClient.Transport)
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient.Transport = rt
+
+	return httpClient, nil
+}
+
+// roundTripper wraps a go-vcr Recorder, persisting the cassette after each recorded interaction.
+// Terraform does not reliably notify providers of shutdown, so waiting until the recorder is stopped
+// could lose all recorded interactions.
+type roundTripper struct {
+	mode     string
+	recorder *recorder.Recorder
+	// Recorded interactions are redacted in place when the cassette is saved,
+	// so no response can be in flight while saving.
+	lock sync.RWMutex
+}
+
+func newRoundTripper(ctx context.Context, mode, cassetteName string, realTransport http.RoundTripper) (*roundTripper, error) {
+	var recorderMode recorder.Mode
+
+	switch mode {
+	case ModeRecording:
+		// Append new interactions to any existing cassette.
+		// Terraform runs a separate provider process for each phase of an operation (e.g. plan and apply).
+		recorderMode = recorder.ModeReplayWithNewEpisodes
+	case ModeReplaying:
+		recorderMode = recorder.ModeReplayOnly
+	default:
+		return nil, fmt.Errorf("unsupported value for %s: %s", EnvVarMode, mode)
+	}
+
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       cassetteName,
+		Mode:               recorderMode,
+		RealTransport:      realTransport,
+		SkipRequestLatency: true,
+	})
+
+	if errors.Is(err, cassette.ErrCassetteNotFound) {
+		return nil, fmt.Errorf("no cassette found (%s.yaml), run in %s mode first: %w", cassetteName, ModeRecording, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove credentials and account IDs.
+	// Redaction is done before saving so that the provider sees the real values while recording.
+	r.AddHook(func(i *cassette.Interaction) error {
+		RedactInteraction(i)
+
+		return nil
+	}, recorder.BeforeSaveHook)
+
+	if mode == ModeRecording {
+		// Never replay while recording.
+		r.SetMatcher(func(*http.Request, cassette.Request) bool {
+			return false
+		})
+	} else {
+		r.SetMatcher(Matcher(ctx))
+	}
+
+	tflog.Info(ctx, "AWS API traffic recorder enabled", map[string]any{
+		"tf_aws.vcr.mode":     mode,
+		"tf_aws.vcr.cassette": cassetteName,
+	})
+
+	return &roundTripper{
+		mode:     mode,
+		recorder: r,
+	}, nil
+}
+
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.lock.RLock()
+	resp, err := rt.recorder.RoundTrip(req)
+	rt.lock.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.mode == ModeRecording {
+		rt.lock.Lock()
+		defer rt.lock.Unlock()
+
+		if err := rt.recorder.Stop(); err != nil {
+			return nil, fmt.Errorf("saving cassette: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+var (
+	sharedSeed     int64
+	sharedSeedErr  error
+	sharedSeedOnce sync.Once
+)
+
+// Seed returns the process-wide seed used for provider-generated unique identifiers.
+// When recording, the seed is read from TF_AWS_VCR_PATH if a previous provider process saved one,
+// otherwise a new seed is generated and saved. When replaying, the saved seed is read.
+func Seed() (int64, error) {
+	sharedSeedOnce.Do(func() {
+		sharedSeed, sharedSeedErr = seed(os.Getenv(EnvVarMode), os.Getenv(EnvVarPath))
+	})
+
+	return sharedSeed, sharedSeedErr
+}
+
+func seed(mode, path string) (int64, error) {
+	fileName := filepath.Join(path, CassetteName+".seed")
+
+	v, err := os.ReadFile(fileName)
+
+	switch {
+	case err == nil:
+		return strconv.ParseInt(strings.TrimSpace(string(v)), 10, 64)
+	case errors.Is(err, fs.ErrNotExist) && mode == ModeRecording:
+		seed := rand.Int63() //nolint:gosec // Not a security-sensitive value.
+
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return 0, err
+		}
+
+		if err := os.WriteFile(fileName, []byte(strconv.FormatInt(seed, 10)), 0644); err != nil { //nolint:gosec // Not a secret.
+			return 0, err
+		}
+
+		return seed, nil
+	default:
+		return 0, fmt.Errorf("reading randomness seed: %w", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -21,7 +22,7 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
@@ -41,6 +42,17 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Check the merged tags against any provider-level tag policy.
+	// CustomizeDiff can't return warnings, so those are logged here and reported when the resource is created or updated.
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
+	if err := tagPolicyConfig.Evaluate(allTags); err != nil {
+		if !tagPolicyConfig.Warn() {
+			return err
+		}
+
+		tflog.Warn(ctx, err.Error())
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
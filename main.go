@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/metrics"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -35,6 +36,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := metrics.WriteFile(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
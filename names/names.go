@@ -195,6 +195,12 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// EndpointURLEnvVar returns the name of the environment variable overriding the service's endpoint URL,
+// e.g. AWS_ENDPOINT_URL_DYNAMODB.
+func EndpointURLEnvVar(service string) string {
+	return "AWS_ENDPOINT_URL_" + strings.ToUpper(service)
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {